@@ -1,5 +1,184 @@
 package main
 
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	configs "Github.com/LocalEats/Order-Service/internal/config"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"Github.com/LocalEats/Order-Service/internal/gateway"
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+	"Github.com/LocalEats/Order-Service/internal/notify"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/policy"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"Github.com/LocalEats/Order-Service/internal/service"
+	"Github.com/LocalEats/Order-Service/internal/storage"
+	"Github.com/LocalEats/Order-Service/internal/token"
+	"Github.com/LocalEats/Order-Service/internal/validate"
+	"Github.com/LocalEats/Order-Service/internal/webhook"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
 func main() {
+	log, err := l.NewLogger()
+	if err != nil {
+		panic(err)
+	}
+	defer log.Sync()
+
+	config := configs.Load()
+
+	db, err := storage.ConnectDB(config)
+	if err != nil {
+		log.Fatal("error connecting to database", zap.Error(err))
+	}
+	defer db.Close()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), db, os.Args[2:]); err != nil {
+			log.Fatal("migrate failed", zap.Error(err))
+		}
+		return
+	}
+
+	if config.MIGRATE_ON_START {
+		if err := runMigrate(context.Background(), db, []string{"up"}); err != nil {
+			log.Fatal("migrate failed", zap.Error(err))
+		}
+	}
+
+	paymentProvider, err := payment.New(config.PAYMENT_PROVIDER)
+	if err != nil {
+		log.Fatal("error selecting payment provider", zap.Error(err))
+	}
+
+	pageSecret := []byte(config.PAGE_TOKEN_SECRET)
+	if len(pageSecret) == 0 {
+		// Page tokens then stop working across restarts and between replicas.
+		log.Warn("PAGE_TOKEN_SECRET is not set, signing page tokens with a random key")
+		pageSecret = make([]byte, 32)
+		if _, err := rand.Read(pageSecret); err != nil {
+			log.Fatal("error generating page token key", zap.Error(err))
+		}
+	}
+
+	idempotencySecret := []byte(config.IDEMPOTENCY_SECRET)
+	if len(idempotencySecret) == 0 {
+		// Retries that reach another replica, or come after a restart, are then
+		// taken for different requests and fail with AlreadyExists.
+		log.Warn("IDEMPOTENCY_SECRET is not set, fingerprinting requests with a random key")
+		idempotencySecret = make([]byte, 32)
+		if _, err := rand.Read(idempotencySecret); err != nil {
+			log.Fatal("error generating idempotency key", zap.Error(err))
+		}
+	}
+
+	orderRepo := repository.NewOrderRepository(db, pricing.Fees{DeliveryFee: config.DELIVERY_FEE, TaxRate: config.TAX_RATE}, paymentProvider, pagetoken.New(pageSecret))
+	kitchenPolicy := policy.NewKitchenPolicy(orderRepo)
+	orderService := service.NewOrderService(orderRepo, kitchenPolicy)
+
+	privateKey, err := token.LoadPrivateKey(config.JWT_PRIVATE_KEY)
+	if err != nil {
+		log.Fatal("error loading jwt signing key", zap.Error(err))
+	}
+	tokens := token.NewManager(privateKey, config.ACCESS_TOKEN_TTL, config.REFRESH_TOKEN_TTL, repository.NewTokenRepository(db))
+
+	userRepo := repository.NewUserRepository(db)
+	kitchenRepo := repository.NewKitchenRepository(db)
+	notifier, err := notify.New(config.NOTIFIER, notify.SMTPConfig{
+		Addr:     config.SMTP_ADDR,
+		From:     config.SMTP_FROM,
+		Username: config.SMTP_USERNAME,
+		Password: config.SMTP_PASSWORD,
+	})
+	if err != nil {
+		log.Fatal("error selecting notifier", zap.Error(err))
+	}
+	if config.NOTIFIER == notify.NotifierLog {
+		log.Warn("NOTIFIER is log, password reset tokens are not delivered")
+	}
+	authService := service.NewAuthService(userRepo, kitchenRepo, tokens, kitchenPolicy, notifier)
+
+	interceptors := []grpc.UnaryServerInterceptor{
+		apperr.UnaryInterceptor,
+		auth.UnaryInterceptor(tokens, auth.PublicMethods),
+		validate.UnaryInterceptor(validate.OrderRules),
+		idempotency.UnaryInterceptor(repository.NewIdempotencyRepository(db), idempotencySecret, config.IDEMPOTENCY_KEY_TTL, idempotency.Methods),
+		payment.TokenizeCards(paymentProvider),
+	}
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
+	pb.RegisterOrderServiceServer(server, orderService)
+	authpb.RegisterAuthServiceServer(server, authService)
+
+	listener, err := net.Listen("tcp", ":"+config.URL_PORT)
+	if err != nil {
+		log.Fatal("error listening", zap.String("port", config.URL_PORT), zap.Error(err))
+	}
+
+	var webhookServer *http.Server
+	if config.WEBHOOK_SECRET == "" {
+		log.Warn("WEBHOOK_SECRET is not set, payment webhooks are disabled")
+	} else {
+		router := gin.New()
+		router.Use(gin.Recovery())
+		webhook.NewHandler([]byte(config.WEBHOOK_SECRET), paymentProvider.Name(), orderRepo).Register(router)
+
+		webhookServer = &http.Server{Addr: ":" + config.WEBHOOK_PORT, Handler: router}
+		go func() {
+			log.Info("webhook server started", zap.String("port", config.WEBHOOK_PORT))
+			if err := webhookServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatal("error serving webhooks", zap.Error(err))
+			}
+		}()
+	}
+
+	gatewayRouter := gin.New()
+	gatewayRouter.Use(gin.Recovery())
+	gateway.New(orderService, interceptors...).Register(gatewayRouter)
+
+	gatewayServer := &http.Server{Addr: ":" + config.GATEWAY_PORT, Handler: gatewayRouter}
+	go func() {
+		log.Info("gateway server started", zap.String("port", config.GATEWAY_PORT))
+		if err := gatewayServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatal("error serving gateway", zap.Error(err))
+		}
+	}()
+
+	go func() {
+		quit := make(chan os.Signal, 1)
+		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+		sig := <-quit
+
+		log.Info("shutting down server", zap.String("signal", sig.String()))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		gatewayServer.Shutdown(ctx)
+		if webhookServer != nil {
+			webhookServer.Shutdown(ctx)
+		}
+		server.GracefulStop()
+	}()
 
+	log.Info("server started", zap.String("port", config.URL_PORT))
+	if err := server.Serve(listener); err != nil {
+		log.Fatal("error serving", zap.Error(err))
+	}
+	log.Info("server stopped")
 }
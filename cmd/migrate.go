@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"Github.com/LocalEats/Order-Service/internal/storage"
+	"Github.com/LocalEats/Order-Service/migrations"
+)
+
+const migrateUsage = "usage: migrate up | down [N] | status | force VERSION"
+
+// runMigrate implements the `migrate` subcommand.
+func runMigrate(ctx context.Context, db *sql.DB, args []string) error {
+	migrator, err := storage.NewMigrator(db, migrations.FS)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, version := range applied {
+			fmt.Printf("applied %d\n", version)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no change")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, version := range reverted {
+			fmt.Printf("reverted %d\n", version)
+		}
+		return err
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version: %d\ndirty: %t\n", status.Version, status.Dirty)
+		for _, m := range status.Pending {
+			fmt.Printf("pending: %d_%s\n", m.Version, m.Name)
+		}
+		return nil
+	case "force":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q", args[1])
+		}
+		return migrator.Force(ctx, uint(version))
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -19,18 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	AuthService_Register_FullMethodName       = "/auth_service.AuthService/Register"
-	AuthService_Login_FullMethodName          = "/auth_service.AuthService/Login"
-	AuthService_GetProfile_FullMethodName     = "/auth_service.AuthService/GetProfile"
-	AuthService_UpdateProfile_FullMethodName  = "/auth_service.AuthService/UpdateProfile"
-	AuthService_ResetPassword_FullMethodName  = "/auth_service.AuthService/ResetPassword"
-	AuthService_RefreshToken_FullMethodName   = "/auth_service.AuthService/RefreshToken"
-	AuthService_Logout_FullMethodName         = "/auth_service.AuthService/Logout"
-	AuthService_CreateKitchen_FullMethodName  = "/auth_service.AuthService/CreateKitchen"
-	AuthService_UpdateKitchen_FullMethodName  = "/auth_service.AuthService/UpdateKitchen"
-	AuthService_GetKitchen_FullMethodName     = "/auth_service.AuthService/GetKitchen"
-	AuthService_ListKitchens_FullMethodName   = "/auth_service.AuthService/ListKitchens"
-	AuthService_SearchKitchens_FullMethodName = "/auth_service.AuthService/SearchKitchens"
+	AuthService_Register_FullMethodName             = "/auth_service.AuthService/Register"
+	AuthService_Login_FullMethodName                = "/auth_service.AuthService/Login"
+	AuthService_GetProfile_FullMethodName           = "/auth_service.AuthService/GetProfile"
+	AuthService_UpdateProfile_FullMethodName        = "/auth_service.AuthService/UpdateProfile"
+	AuthService_ResetPassword_FullMethodName        = "/auth_service.AuthService/ResetPassword"
+	AuthService_ConfirmPasswordReset_FullMethodName = "/auth_service.AuthService/ConfirmPasswordReset"
+	AuthService_RefreshToken_FullMethodName         = "/auth_service.AuthService/RefreshToken"
+	AuthService_Logout_FullMethodName               = "/auth_service.AuthService/Logout"
+	AuthService_CreateKitchen_FullMethodName        = "/auth_service.AuthService/CreateKitchen"
+	AuthService_UpdateKitchen_FullMethodName        = "/auth_service.AuthService/UpdateKitchen"
+	AuthService_GetKitchen_FullMethodName           = "/auth_service.AuthService/GetKitchen"
+	AuthService_ListKitchens_FullMethodName         = "/auth_service.AuthService/ListKitchens"
+	AuthService_SearchKitchens_FullMethodName       = "/auth_service.AuthService/SearchKitchens"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -42,6 +43,7 @@ type AuthServiceClient interface {
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*ResetPasswordResponse, error)
+	ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
 	CreateKitchen(ctx context.Context, in *CreateKitchenRequest, opts ...grpc.CallOption) (*CreateKitchenResponse, error)
@@ -109,6 +111,16 @@ func (c *authServiceClient) ResetPassword(ctx context.Context, in *ResetPassword
 	return out, nil
 }
 
+func (c *authServiceClient) ConfirmPasswordReset(ctx context.Context, in *ConfirmPasswordResetRequest, opts ...grpc.CallOption) (*ConfirmPasswordResetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPasswordResetResponse)
+	err := c.cc.Invoke(ctx, AuthService_ConfirmPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RefreshTokenResponse)
@@ -188,6 +200,7 @@ type AuthServiceServer interface {
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error)
 	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
 	CreateKitchen(context.Context, *CreateKitchenRequest) (*CreateKitchenResponse, error)
@@ -217,6 +230,9 @@ func (UnimplementedAuthServiceServer) UpdateProfile(context.Context, *UpdateProf
 func (UnimplementedAuthServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
+func (UnimplementedAuthServiceServer) ConfirmPasswordReset(context.Context, *ConfirmPasswordResetRequest) (*ConfirmPasswordResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPasswordReset not implemented")
+}
 func (UnimplementedAuthServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
 }
@@ -341,6 +357,24 @@ func _AuthService_ResetPassword_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ConfirmPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ConfirmPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ConfirmPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ConfirmPasswordReset(ctx, req.(*ConfirmPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefreshTokenRequest)
 	if err := dec(in); err != nil {
@@ -494,6 +528,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetPassword",
 			Handler:    _AuthService_ResetPassword_Handler,
 		},
+		{
+			MethodName: "ConfirmPasswordReset",
+			Handler:    _AuthService_ConfirmPasswordReset_Handler,
+		},
 		{
 			MethodName: "RefreshToken",
 			Handler:    _AuthService_RefreshToken_Handler,
@@ -315,9 +315,17 @@ type ListDishesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KitchenId string `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
-	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	KitchenId        string   `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	Page             int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit            int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken        string   `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Category         string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	MinPrice         float64  `protobuf:"fixed64,6,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice         float64  `protobuf:"fixed64,7,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AvailableOnly    bool     `protobuf:"varint,8,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"`
+	DietaryInfo      []string `protobuf:"bytes,9,rep,name=dietary_info,json=dietaryInfo,proto3" json:"dietary_info,omitempty"`
+	ExcludeAllergens []string `protobuf:"bytes,10,rep,name=exclude_allergens,json=excludeAllergens,proto3" json:"exclude_allergens,omitempty"`
+	SortBy           string   `protobuf:"bytes,11,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 }
 
 func (x *ListDishesRequest) Reset() {
@@ -373,15 +381,72 @@ func (x *ListDishesRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListDishesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDishesRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListDishesRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListDishesRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListDishesRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+func (x *ListDishesRequest) GetDietaryInfo() []string {
+	if x != nil {
+		return x.DietaryInfo
+	}
+	return nil
+}
+
+func (x *ListDishesRequest) GetExcludeAllergens() []string {
+	if x != nil {
+		return x.ExcludeAllergens
+	}
+	return nil
+}
+
+func (x *ListDishesRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
 type ListDishesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dishes []*Dish `protobuf:"bytes,1,rep,name=dishes,proto3" json:"dishes,omitempty"`
-	Total  int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page   int32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit  int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Dishes        []*Dish `protobuf:"bytes,1,rep,name=dishes,proto3" json:"dishes,omitempty"`
+	Total         int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string  `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListDishesResponse) Reset() {
@@ -444,6 +509,13 @@ func (x *ListDishesResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListDishesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -545,6 +617,7 @@ type UpdateOrderStatusRequest struct {
 
 	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (x *UpdateOrderStatusRequest) Reset() {
@@ -593,6 +666,13 @@ func (x *UpdateOrderStatusRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type UpdateOrderStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -666,6 +746,7 @@ type ListOrdersRequest struct {
 	Status    string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	Page      int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
 	Limit     int32  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ListOrdersRequest) Reset() {
@@ -735,15 +816,23 @@ func (x *ListOrdersRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total  int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page   int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	Limit  int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Orders        []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32    `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string   `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListOrdersResponse) Reset() {
@@ -806,14 +895,23 @@ func (x *ListOrdersResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KitchenID string `protobuf:"bytes,1,opt,name=KitchenID,proto3" json:"KitchenID,omitempty"`
-	Page      int32  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
-	Limit     int32  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	KitchenID      string `protobuf:"bytes,1,opt,name=KitchenID,proto3" json:"KitchenID,omitempty"`
+	Page           int32  `protobuf:"varint,2,opt,name=Page,proto3" json:"Page,omitempty"`
+	Limit          int32  `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	IncludeHistory bool   `protobuf:"varint,4,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+	PageToken      string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *GetOrderRequest) Reset() {
@@ -869,12 +967,27 @@ func (x *GetOrderRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetOrderRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+func (x *GetOrderRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type GetOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Order []*Order `protobuf:"bytes,1,rep,name=order,proto3" json:"order,omitempty"`
+	Order         []*Order `protobuf:"bytes,1,rep,name=order,proto3" json:"order,omitempty"`
+	NextPageToken string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *GetOrderResponse) Reset() {
@@ -916,6 +1029,123 @@ func (x *GetOrderResponse) GetOrder() []*Order {
 	return nil
 }
 
+func (x *GetOrderResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderRequest) ProtoMessage() {}
+
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order   *Order     `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Refunds []*Payment `protobuf:"bytes,2,rep,name=refunds,proto3" json:"refunds,omitempty"`
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOrderResponse) ProtoMessage() {}
+
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *CancelOrderResponse) GetRefunds() []*Payment {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
 type CreateReviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -927,7 +1157,7 @@ type CreateReviewRequest struct {
 func (x *CreateReviewRequest) Reset() {
 	*x = CreateReviewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[16]
+		mi := &file_order_order_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +1170,7 @@ func (x *CreateReviewRequest) String() string {
 func (*CreateReviewRequest) ProtoMessage() {}
 
 func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[16]
+	mi := &file_order_order_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1183,7 @@ func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
 func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{16}
+	return file_order_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateReviewRequest) GetReview() *Review {
@@ -974,7 +1204,7 @@ type CreateReviewResponse struct {
 func (x *CreateReviewResponse) Reset() {
 	*x = CreateReviewResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[17]
+		mi := &file_order_order_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1217,7 @@ func (x *CreateReviewResponse) String() string {
 func (*CreateReviewResponse) ProtoMessage() {}
 
 func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[17]
+	mi := &file_order_order_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1230,7 @@ func (x *CreateReviewResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateReviewResponse.ProtoReflect.Descriptor instead.
 func (*CreateReviewResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{17}
+	return file_order_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateReviewResponse) GetReview() *Review {
@@ -1018,12 +1248,13 @@ type ListReviewsRequest struct {
 	KitchenId string `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
 	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
 	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (x *ListReviewsRequest) Reset() {
 	*x = ListReviewsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[18]
+		mi := &file_order_order_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1036,7 +1267,7 @@ func (x *ListReviewsRequest) String() string {
 func (*ListReviewsRequest) ProtoMessage() {}
 
 func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[18]
+	mi := &file_order_order_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,7 +1280,7 @@ func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
 func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{18}
+	return file_order_order_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListReviewsRequest) GetKitchenId() string {
@@ -1073,6 +1304,13 @@ func (x *ListReviewsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListReviewsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1083,12 +1321,13 @@ type ListReviewsResponse struct {
 	AverageRating float32   `protobuf:"fixed32,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
 	Page          int32     `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32     `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPageToken string    `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListReviewsResponse) Reset() {
 	*x = ListReviewsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[19]
+		mi := &file_order_order_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +1340,7 @@ func (x *ListReviewsResponse) String() string {
 func (*ListReviewsResponse) ProtoMessage() {}
 
 func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[19]
+	mi := &file_order_order_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +1353,7 @@ func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
 func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{19}
+	return file_order_order_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ListReviewsResponse) GetReviews() []*Review {
@@ -1152,6 +1391,13 @@ func (x *ListReviewsResponse) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type CreatePaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1163,7 +1409,7 @@ type CreatePaymentRequest struct {
 func (x *CreatePaymentRequest) Reset() {
 	*x = CreatePaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[20]
+		mi := &file_order_order_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1176,7 +1422,7 @@ func (x *CreatePaymentRequest) String() string {
 func (*CreatePaymentRequest) ProtoMessage() {}
 
 func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[20]
+	mi := &file_order_order_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1435,7 @@ func (x *CreatePaymentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentRequest.ProtoReflect.Descriptor instead.
 func (*CreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{20}
+	return file_order_order_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreatePaymentRequest) GetPayment() *Payment {
@@ -1210,7 +1456,7 @@ type CreatePaymentResponse struct {
 func (x *CreatePaymentResponse) Reset() {
 	*x = CreatePaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[21]
+		mi := &file_order_order_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1223,7 +1469,7 @@ func (x *CreatePaymentResponse) String() string {
 func (*CreatePaymentResponse) ProtoMessage() {}
 
 func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[21]
+	mi := &file_order_order_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1482,7 @@ func (x *CreatePaymentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePaymentResponse.ProtoReflect.Descriptor instead.
 func (*CreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{21}
+	return file_order_order_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CreatePaymentResponse) GetPayment() *Payment {
@@ -1246,31 +1492,31 @@ func (x *CreatePaymentResponse) GetPayment() *Payment {
 	return nil
 }
 
-type GetDishRecommendationsRequest struct {
+type GetPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentId string `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
 }
 
-func (x *GetDishRecommendationsRequest) Reset() {
-	*x = GetDishRecommendationsRequest{}
+func (x *GetPaymentRequest) Reset() {
+	*x = GetPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[22]
+		mi := &file_order_order_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDishRecommendationsRequest) String() string {
+func (x *GetPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDishRecommendationsRequest) ProtoMessage() {}
+func (*GetPaymentRequest) ProtoMessage() {}
 
-func (x *GetDishRecommendationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[22]
+func (x *GetPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1281,44 +1527,43 @@ func (x *GetDishRecommendationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDishRecommendationsRequest.ProtoReflect.Descriptor instead.
-func (*GetDishRecommendationsRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetPaymentRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetDishRecommendationsRequest) GetUserId() string {
+func (x *GetPaymentRequest) GetPaymentId() string {
 	if x != nil {
-		return x.UserId
+		return x.PaymentId
 	}
 	return ""
 }
 
-type GetDishRecommendationsResponse struct {
+type GetPaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recommendations []*Dish `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
-	Total           int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
 }
 
-func (x *GetDishRecommendationsResponse) Reset() {
-	*x = GetDishRecommendationsResponse{}
+func (x *GetPaymentResponse) Reset() {
+	*x = GetPaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[23]
+		mi := &file_order_order_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDishRecommendationsResponse) String() string {
+func (x *GetPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDishRecommendationsResponse) ProtoMessage() {}
+func (*GetPaymentResponse) ProtoMessage() {}
 
-func (x *GetDishRecommendationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[23]
+func (x *GetPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1329,52 +1574,48 @@ func (x *GetDishRecommendationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDishRecommendationsResponse.ProtoReflect.Descriptor instead.
-func (*GetDishRecommendationsResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetPaymentResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetDishRecommendationsResponse) GetRecommendations() []*Dish {
+func (x *GetPaymentResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.Recommendations
+		return x.Payment
 	}
 	return nil
 }
 
-func (x *GetDishRecommendationsResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetKitchenStatisticsRequest struct {
+type ListPaymentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KitchenId string `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
-	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KitchenId string `protobuf:"bytes,3,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	Status    string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Page      int32  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetKitchenStatisticsRequest) Reset() {
-	*x = GetKitchenStatisticsRequest{}
+func (x *ListPaymentsRequest) Reset() {
+	*x = ListPaymentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[24]
+		mi := &file_order_order_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetKitchenStatisticsRequest) String() string {
+func (x *ListPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKitchenStatisticsRequest) ProtoMessage() {}
+func (*ListPaymentsRequest) ProtoMessage() {}
 
-func (x *GetKitchenStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[24]
+func (x *ListPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,61 +1626,81 @@ func (x *GetKitchenStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKitchenStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetKitchenStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ListPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetKitchenStatisticsRequest) GetKitchenId() string {
+func (x *ListPaymentsRequest) GetOrderId() string {
 	if x != nil {
-		return x.KitchenId
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *GetKitchenStatisticsRequest) GetStartDate() string {
+func (x *ListPaymentsRequest) GetUserId() string {
 	if x != nil {
-		return x.StartDate
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetKitchenStatisticsRequest) GetEndDate() string {
+func (x *ListPaymentsRequest) GetKitchenId() string {
 	if x != nil {
-		return x.EndDate
+		return x.KitchenId
 	}
 	return ""
 }
 
-type GetKitchenStatisticsResponse struct {
+func (x *ListPaymentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPaymentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPaymentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPaymentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalOrders   int32          `protobuf:"varint,1,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
-	TotalRevenue  float64        `protobuf:"fixed64,2,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
-	AverageRating float32        `protobuf:"fixed32,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
-	TopDishes     []*TopDish     `protobuf:"bytes,4,rep,name=top_dishes,json=topDishes,proto3" json:"top_dishes,omitempty"`
-	BusiestHours  []*BusiestHour `protobuf:"bytes,5,rep,name=busiest_hours,json=busiestHours,proto3" json:"busiest_hours,omitempty"`
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Total    int32      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page     int32      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit    int32      `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetKitchenStatisticsResponse) Reset() {
-	*x = GetKitchenStatisticsResponse{}
+func (x *ListPaymentsResponse) Reset() {
+	*x = ListPaymentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[25]
+		mi := &file_order_order_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetKitchenStatisticsResponse) String() string {
+func (x *ListPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetKitchenStatisticsResponse) ProtoMessage() {}
+func (*ListPaymentsResponse) ProtoMessage() {}
 
-func (x *GetKitchenStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[25]
+func (x *ListPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,74 +1711,68 @@ func (x *GetKitchenStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetKitchenStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetKitchenStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ListPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetKitchenStatisticsResponse) GetTotalOrders() int32 {
+func (x *ListPaymentsResponse) GetPayments() []*Payment {
 	if x != nil {
-		return x.TotalOrders
+		return x.Payments
 	}
-	return 0
+	return nil
 }
 
-func (x *GetKitchenStatisticsResponse) GetTotalRevenue() float64 {
+func (x *ListPaymentsResponse) GetTotal() int32 {
 	if x != nil {
-		return x.TotalRevenue
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetKitchenStatisticsResponse) GetAverageRating() float32 {
+func (x *ListPaymentsResponse) GetPage() int32 {
 	if x != nil {
-		return x.AverageRating
+		return x.Page
 	}
 	return 0
 }
 
-func (x *GetKitchenStatisticsResponse) GetTopDishes() []*TopDish {
-	if x != nil {
-		return x.TopDishes
-	}
-	return nil
-}
-
-func (x *GetKitchenStatisticsResponse) GetBusiestHours() []*BusiestHour {
+func (x *ListPaymentsResponse) GetLimit() int32 {
 	if x != nil {
-		return x.BusiestHours
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-type TopDish struct {
+type RefundPaymentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OrdersCount int32   `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
-	Revenue     float64 `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
+	PaymentId string  `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	Amount    float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	DishId    string  `protobuf:"bytes,3,opt,name=dish_id,json=dishId,proto3" json:"dish_id,omitempty"`
+	Quantity  int32   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason    string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *TopDish) Reset() {
-	*x = TopDish{}
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[26]
+		mi := &file_order_order_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TopDish) String() string {
+func (x *RefundPaymentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopDish) ProtoMessage() {}
+func (*RefundPaymentRequest) ProtoMessage() {}
 
-func (x *TopDish) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[26]
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1528,65 +1783,72 @@ func (x *TopDish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopDish.ProtoReflect.Descriptor instead.
-func (*TopDish) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *TopDish) GetId() string {
+func (x *RefundPaymentRequest) GetPaymentId() string {
 	if x != nil {
-		return x.Id
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *TopDish) GetName() string {
+func (x *RefundPaymentRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Name
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetDishId() string {
+	if x != nil {
+		return x.DishId
 	}
 	return ""
 }
 
-func (x *TopDish) GetOrdersCount() int32 {
+func (x *RefundPaymentRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.OrdersCount
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *TopDish) GetRevenue() float64 {
+func (x *RefundPaymentRequest) GetReason() string {
 	if x != nil {
-		return x.Revenue
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-type BusiestHour struct {
+type RefundPaymentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hour        int32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
-	OrdersCount int32 `protobuf:"varint,2,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Refund  *Refund  `protobuf:"bytes,2,opt,name=refund,proto3" json:"refund,omitempty"`
 }
 
-func (x *BusiestHour) Reset() {
-	*x = BusiestHour{}
+func (x *RefundPaymentResponse) Reset() {
+	*x = RefundPaymentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[27]
+		mi := &file_order_order_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BusiestHour) String() string {
+func (x *RefundPaymentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BusiestHour) ProtoMessage() {}
+func (*RefundPaymentResponse) ProtoMessage() {}
 
-func (x *BusiestHour) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[27]
+func (x *RefundPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1597,52 +1859,50 @@ func (x *BusiestHour) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BusiestHour.ProtoReflect.Descriptor instead.
-func (*BusiestHour) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RefundPaymentResponse.ProtoReflect.Descriptor instead.
+func (*RefundPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *BusiestHour) GetHour() int32 {
+func (x *RefundPaymentResponse) GetPayment() *Payment {
 	if x != nil {
-		return x.Hour
+		return x.Payment
 	}
-	return 0
+	return nil
 }
 
-func (x *BusiestHour) GetOrdersCount() int32 {
+func (x *RefundPaymentResponse) GetRefund() *Refund {
 	if x != nil {
-		return x.OrdersCount
+		return x.Refund
 	}
-	return 0
+	return nil
 }
 
-type GetUserActivityRequest struct {
+type GetDishRecommendationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *GetUserActivityRequest) Reset() {
-	*x = GetUserActivityRequest{}
+func (x *GetDishRecommendationsRequest) Reset() {
+	*x = GetDishRecommendationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[28]
+		mi := &file_order_order_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActivityRequest) String() string {
+func (x *GetDishRecommendationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActivityRequest) ProtoMessage() {}
+func (*GetDishRecommendationsRequest) ProtoMessage() {}
 
-func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[28]
+func (x *GetDishRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1653,57 +1913,44 @@ func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActivityRequest.ProtoReflect.Descriptor instead.
-func (*GetUserActivityRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetDishRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetDishRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetUserActivityRequest) GetUserId() string {
+func (x *GetDishRecommendationsRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetUserActivityRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *GetUserActivityRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-type GetUserActivityResponse struct {
+type GetDishRecommendationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserActivity []*UserActivity `protobuf:"bytes,1,rep,name=UserActivity,proto3" json:"UserActivity,omitempty"`
+	Recommendations []*Dish `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	Total           int32   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *GetUserActivityResponse) Reset() {
-	*x = GetUserActivityResponse{}
+func (x *GetDishRecommendationsResponse) Reset() {
+	*x = GetDishRecommendationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[29]
+		mi := &file_order_order_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserActivityResponse) String() string {
+func (x *GetDishRecommendationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActivityResponse) ProtoMessage() {}
+func (*GetDishRecommendationsResponse) ProtoMessage() {}
 
-func (x *GetUserActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[29]
+func (x *GetDishRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1714,44 +1961,52 @@ func (x *GetUserActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActivityResponse.ProtoReflect.Descriptor instead.
-func (*GetUserActivityResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetDishRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*GetDishRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetUserActivityResponse) GetUserActivity() []*UserActivity {
+func (x *GetDishRecommendationsResponse) GetRecommendations() []*Dish {
 	if x != nil {
-		return x.UserActivity
+		return x.Recommendations
 	}
 	return nil
 }
 
-type FavoriteCuisine struct {
+func (x *GetDishRecommendationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetKitchenStatisticsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CuisineType string `protobuf:"bytes,1,opt,name=cuisine_type,json=cuisineType,proto3" json:"cuisine_type,omitempty"`
-	OrdersCount int32  `protobuf:"varint,2,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	KitchenId string `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *FavoriteCuisine) Reset() {
-	*x = FavoriteCuisine{}
+func (x *GetKitchenStatisticsRequest) Reset() {
+	*x = GetKitchenStatisticsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[30]
+		mi := &file_order_order_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FavoriteCuisine) String() string {
+func (x *GetKitchenStatisticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteCuisine) ProtoMessage() {}
+func (*GetKitchenStatisticsRequest) ProtoMessage() {}
 
-func (x *FavoriteCuisine) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[30]
+func (x *GetKitchenStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1762,52 +2017,63 @@ func (x *FavoriteCuisine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteCuisine.ProtoReflect.Descriptor instead.
-func (*FavoriteCuisine) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetKitchenStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetKitchenStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FavoriteCuisine) GetCuisineType() string {
+func (x *GetKitchenStatisticsRequest) GetKitchenId() string {
 	if x != nil {
-		return x.CuisineType
+		return x.KitchenId
 	}
 	return ""
 }
 
-func (x *FavoriteCuisine) GetOrdersCount() int32 {
+func (x *GetKitchenStatisticsRequest) GetStartDate() string {
 	if x != nil {
-		return x.OrdersCount
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-type FavoriteKitchen struct {
+func (x *GetKitchenStatisticsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetKitchenStatisticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	OrdersCount int32  `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	TotalOrders    int32          `protobuf:"varint,1,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	TotalRevenue   float64        `protobuf:"fixed64,2,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	AverageRating  float32        `protobuf:"fixed32,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"`
+	TopDishes      []*TopDish     `protobuf:"bytes,4,rep,name=top_dishes,json=topDishes,proto3" json:"top_dishes,omitempty"`
+	BusiestHours   []*BusiestHour `protobuf:"bytes,5,rep,name=busiest_hours,json=busiestHours,proto3" json:"busiest_hours,omitempty"`
+	GrossRevenue   float64        `protobuf:"fixed64,6,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue,omitempty"`
+	RefundedAmount float64        `protobuf:"fixed64,7,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
 }
 
-func (x *FavoriteKitchen) Reset() {
-	*x = FavoriteKitchen{}
+func (x *GetKitchenStatisticsResponse) Reset() {
+	*x = GetKitchenStatisticsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[31]
+		mi := &file_order_order_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FavoriteKitchen) String() string {
+func (x *GetKitchenStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FavoriteKitchen) ProtoMessage() {}
+func (*GetKitchenStatisticsResponse) ProtoMessage() {}
 
-func (x *FavoriteKitchen) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[31]
+func (x *GetKitchenStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,58 +2084,88 @@ func (x *FavoriteKitchen) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FavoriteKitchen.ProtoReflect.Descriptor instead.
-func (*FavoriteKitchen) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetKitchenStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetKitchenStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *FavoriteKitchen) GetId() string {
+func (x *GetKitchenStatisticsResponse) GetTotalOrders() int32 {
 	if x != nil {
-		return x.Id
+		return x.TotalOrders
 	}
-	return ""
+	return 0
 }
 
-func (x *FavoriteKitchen) GetName() string {
+func (x *GetKitchenStatisticsResponse) GetTotalRevenue() float64 {
 	if x != nil {
-		return x.Name
+		return x.TotalRevenue
 	}
-	return ""
+	return 0
 }
 
-func (x *FavoriteKitchen) GetOrdersCount() int32 {
+func (x *GetKitchenStatisticsResponse) GetAverageRating() float32 {
 	if x != nil {
-		return x.OrdersCount
+		return x.AverageRating
 	}
 	return 0
 }
 
-type UpdateWorkingHoursRequest struct {
+func (x *GetKitchenStatisticsResponse) GetTopDishes() []*TopDish {
+	if x != nil {
+		return x.TopDishes
+	}
+	return nil
+}
+
+func (x *GetKitchenStatisticsResponse) GetBusiestHours() []*BusiestHour {
+	if x != nil {
+		return x.BusiestHours
+	}
+	return nil
+}
+
+func (x *GetKitchenStatisticsResponse) GetGrossRevenue() float64 {
+	if x != nil {
+		return x.GrossRevenue
+	}
+	return 0
+}
+
+func (x *GetKitchenStatisticsResponse) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+type TopDish struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KitchenId    string          `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
-	WorkingHours []*WorkingHours `protobuf:"bytes,2,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrdersCount int32   `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+	Revenue     float64 `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue,omitempty"`
 }
 
-func (x *UpdateWorkingHoursRequest) Reset() {
-	*x = UpdateWorkingHoursRequest{}
+func (x *TopDish) Reset() {
+	*x = TopDish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[32]
+		mi := &file_order_order_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateWorkingHoursRequest) String() string {
+func (x *TopDish) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkingHoursRequest) ProtoMessage() {}
+func (*TopDish) ProtoMessage() {}
 
-func (x *UpdateWorkingHoursRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[32]
+func (x *TopDish) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,52 +2176,65 @@ func (x *UpdateWorkingHoursRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkingHoursRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkingHoursRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use TopDish.ProtoReflect.Descriptor instead.
+func (*TopDish) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UpdateWorkingHoursRequest) GetKitchenId() string {
+func (x *TopDish) GetId() string {
 	if x != nil {
-		return x.KitchenId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateWorkingHoursRequest) GetWorkingHours() []*WorkingHours {
+func (x *TopDish) GetName() string {
 	if x != nil {
-		return x.WorkingHours
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type WorkingHours struct {
+func (x *TopDish) GetOrdersCount() int32 {
+	if x != nil {
+		return x.OrdersCount
+	}
+	return 0
+}
+
+func (x *TopDish) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
+	}
+	return 0
+}
+
+type BusiestHour struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DayOfWeek int32  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
-	OpenTime  string `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
-	CloseTime string `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Hour        int32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	OrdersCount int32 `protobuf:"varint,2,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
 }
 
-func (x *WorkingHours) Reset() {
-	*x = WorkingHours{}
+func (x *BusiestHour) Reset() {
+	*x = BusiestHour{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[33]
+		mi := &file_order_order_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkingHours) String() string {
+func (x *BusiestHour) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkingHours) ProtoMessage() {}
+func (*BusiestHour) ProtoMessage() {}
 
-func (x *WorkingHours) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[33]
+func (x *BusiestHour) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1936,59 +2245,52 @@ func (x *WorkingHours) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
-func (*WorkingHours) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BusiestHour.ProtoReflect.Descriptor instead.
+func (*BusiestHour) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *WorkingHours) GetDayOfWeek() int32 {
+func (x *BusiestHour) GetHour() int32 {
 	if x != nil {
-		return x.DayOfWeek
+		return x.Hour
 	}
 	return 0
 }
 
-func (x *WorkingHours) GetOpenTime() string {
-	if x != nil {
-		return x.OpenTime
-	}
-	return ""
-}
-
-func (x *WorkingHours) GetCloseTime() string {
+func (x *BusiestHour) GetOrdersCount() int32 {
 	if x != nil {
-		return x.CloseTime
+		return x.OrdersCount
 	}
-	return ""
+	return 0
 }
 
-type UpdateWorkingHoursResponse struct {
+type GetUserActivityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KitchenId    string          `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
-	WorkingHours []*WorkingHours `protobuf:"bytes,2,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
-	UpdatedAt    string          `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartDate string `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *UpdateWorkingHoursResponse) Reset() {
-	*x = UpdateWorkingHoursResponse{}
+func (x *GetUserActivityRequest) Reset() {
+	*x = GetUserActivityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[34]
+		mi := &file_order_order_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateWorkingHoursResponse) String() string {
+func (x *GetUserActivityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateWorkingHoursResponse) ProtoMessage() {}
+func (*GetUserActivityRequest) ProtoMessage() {}
 
-func (x *UpdateWorkingHoursResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[34]
+func (x *GetUserActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,63 +2301,57 @@ func (x *UpdateWorkingHoursResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateWorkingHoursResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkingHoursResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use GetUserActivityRequest.ProtoReflect.Descriptor instead.
+func (*GetUserActivityRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *UpdateWorkingHoursResponse) GetKitchenId() string {
+func (x *GetUserActivityRequest) GetUserId() string {
 	if x != nil {
-		return x.KitchenId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateWorkingHoursResponse) GetWorkingHours() []*WorkingHours {
+func (x *GetUserActivityRequest) GetStartDate() string {
 	if x != nil {
-		return x.WorkingHours
+		return x.StartDate
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateWorkingHoursResponse) GetUpdatedAt() string {
+func (x *GetUserActivityRequest) GetEndDate() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.EndDate
 	}
 	return ""
 }
 
-type UpdateDishNutritionInfoRequest struct {
+type GetUserActivityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	DishId        string   `protobuf:"bytes,1,opt,name=dish_id,json=dishId,proto3" json:"dish_id,omitempty"`
-	Allergens     []string `protobuf:"bytes,2,rep,name=allergens,proto3" json:"allergens,omitempty"`
-	Calories      int32    `protobuf:"varint,3,opt,name=calories,proto3" json:"calories,omitempty"`
-	Protein       int32    `protobuf:"varint,4,opt,name=protein,proto3" json:"protein,omitempty"`
-	Carbohydrates int32    `protobuf:"varint,5,opt,name=carbohydrates,proto3" json:"carbohydrates,omitempty"`
-	Fat           int32    `protobuf:"varint,6,opt,name=fat,proto3" json:"fat,omitempty"`
-	DietaryInfo   []string `protobuf:"bytes,7,rep,name=dietary_info,json=dietaryInfo,proto3" json:"dietary_info,omitempty"`
+	UserActivity []*UserActivity `protobuf:"bytes,1,rep,name=UserActivity,proto3" json:"UserActivity,omitempty"`
 }
 
-func (x *UpdateDishNutritionInfoRequest) Reset() {
-	*x = UpdateDishNutritionInfoRequest{}
+func (x *GetUserActivityResponse) Reset() {
+	*x = GetUserActivityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[35]
+		mi := &file_order_order_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDishNutritionInfoRequest) String() string {
+func (x *GetUserActivityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDishNutritionInfoRequest) ProtoMessage() {}
+func (*GetUserActivityResponse) ProtoMessage() {}
 
-func (x *UpdateDishNutritionInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[35]
+func (x *GetUserActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,29 +2362,381 @@ func (x *UpdateDishNutritionInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDishNutritionInfoRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDishNutritionInfoRequest) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *UpdateDishNutritionInfoRequest) GetDishId() string {
-	if x != nil {
-		return x.DishId
-	}
-	return ""
+// Deprecated: Use GetUserActivityResponse.ProtoReflect.Descriptor instead.
+func (*GetUserActivityResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateDishNutritionInfoRequest) GetAllergens() []string {
+func (x *GetUserActivityResponse) GetUserActivity() []*UserActivity {
 	if x != nil {
-		return x.Allergens
+		return x.UserActivity
 	}
 	return nil
 }
 
-func (x *UpdateDishNutritionInfoRequest) GetCalories() int32 {
-	if x != nil {
-		return x.Calories
-	}
+type FavoriteCuisine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CuisineType string `protobuf:"bytes,1,opt,name=cuisine_type,json=cuisineType,proto3" json:"cuisine_type,omitempty"`
+	OrdersCount int32  `protobuf:"varint,2,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+}
+
+func (x *FavoriteCuisine) Reset() {
+	*x = FavoriteCuisine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteCuisine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteCuisine) ProtoMessage() {}
+
+func (x *FavoriteCuisine) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteCuisine.ProtoReflect.Descriptor instead.
+func (*FavoriteCuisine) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FavoriteCuisine) GetCuisineType() string {
+	if x != nil {
+		return x.CuisineType
+	}
+	return ""
+}
+
+func (x *FavoriteCuisine) GetOrdersCount() int32 {
+	if x != nil {
+		return x.OrdersCount
+	}
+	return 0
+}
+
+type FavoriteKitchen struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	OrdersCount int32  `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+}
+
+func (x *FavoriteKitchen) Reset() {
+	*x = FavoriteKitchen{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FavoriteKitchen) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FavoriteKitchen) ProtoMessage() {}
+
+func (x *FavoriteKitchen) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteKitchen.ProtoReflect.Descriptor instead.
+func (*FavoriteKitchen) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FavoriteKitchen) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FavoriteKitchen) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FavoriteKitchen) GetOrdersCount() int32 {
+	if x != nil {
+		return x.OrdersCount
+	}
+	return 0
+}
+
+type UpdateWorkingHoursRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KitchenId    string          `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	WorkingHours []*WorkingHours `protobuf:"bytes,2,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+}
+
+func (x *UpdateWorkingHoursRequest) Reset() {
+	*x = UpdateWorkingHoursRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkingHoursRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkingHoursRequest) ProtoMessage() {}
+
+func (x *UpdateWorkingHoursRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkingHoursRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkingHoursRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateWorkingHoursRequest) GetKitchenId() string {
+	if x != nil {
+		return x.KitchenId
+	}
+	return ""
+}
+
+func (x *UpdateWorkingHoursRequest) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+type WorkingHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DayOfWeek int32  `protobuf:"varint,1,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	OpenTime  string `protobuf:"bytes,2,opt,name=open_time,json=openTime,proto3" json:"open_time,omitempty"`
+	CloseTime string `protobuf:"bytes,3,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+}
+
+func (x *WorkingHours) Reset() {
+	*x = WorkingHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingHours) ProtoMessage() {}
+
+func (x *WorkingHours) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingHours.ProtoReflect.Descriptor instead.
+func (*WorkingHours) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WorkingHours) GetDayOfWeek() int32 {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return 0
+}
+
+func (x *WorkingHours) GetOpenTime() string {
+	if x != nil {
+		return x.OpenTime
+	}
+	return ""
+}
+
+func (x *WorkingHours) GetCloseTime() string {
+	if x != nil {
+		return x.CloseTime
+	}
+	return ""
+}
+
+type UpdateWorkingHoursResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KitchenId    string          `protobuf:"bytes,1,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	WorkingHours []*WorkingHours `protobuf:"bytes,2,rep,name=working_hours,json=workingHours,proto3" json:"working_hours,omitempty"`
+	UpdatedAt    string          `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *UpdateWorkingHoursResponse) Reset() {
+	*x = UpdateWorkingHoursResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkingHoursResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkingHoursResponse) ProtoMessage() {}
+
+func (x *UpdateWorkingHoursResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkingHoursResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkingHoursResponse) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateWorkingHoursResponse) GetKitchenId() string {
+	if x != nil {
+		return x.KitchenId
+	}
+	return ""
+}
+
+func (x *UpdateWorkingHoursResponse) GetWorkingHours() []*WorkingHours {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return nil
+}
+
+func (x *UpdateWorkingHoursResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpdateDishNutritionInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DishId        string   `protobuf:"bytes,1,opt,name=dish_id,json=dishId,proto3" json:"dish_id,omitempty"`
+	Allergens     []string `protobuf:"bytes,2,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	Calories      int32    `protobuf:"varint,3,opt,name=calories,proto3" json:"calories,omitempty"`
+	Protein       int32    `protobuf:"varint,4,opt,name=protein,proto3" json:"protein,omitempty"`
+	Carbohydrates int32    `protobuf:"varint,5,opt,name=carbohydrates,proto3" json:"carbohydrates,omitempty"`
+	Fat           int32    `protobuf:"varint,6,opt,name=fat,proto3" json:"fat,omitempty"`
+	DietaryInfo   []string `protobuf:"bytes,7,rep,name=dietary_info,json=dietaryInfo,proto3" json:"dietary_info,omitempty"`
+}
+
+func (x *UpdateDishNutritionInfoRequest) Reset() {
+	*x = UpdateDishNutritionInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDishNutritionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDishNutritionInfoRequest) ProtoMessage() {}
+
+func (x *UpdateDishNutritionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDishNutritionInfoRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDishNutritionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *UpdateDishNutritionInfoRequest) GetDishId() string {
+	if x != nil {
+		return x.DishId
+	}
+	return ""
+}
+
+func (x *UpdateDishNutritionInfoRequest) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *UpdateDishNutritionInfoRequest) GetCalories() int32 {
+	if x != nil {
+		return x.Calories
+	}
 	return 0
 }
 
@@ -2131,7 +2779,7 @@ type UpdateDishNutritionInfoResponse struct {
 func (x *UpdateDishNutritionInfoResponse) Reset() {
 	*x = UpdateDishNutritionInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[36]
+		mi := &file_order_order_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2144,7 +2792,7 @@ func (x *UpdateDishNutritionInfoResponse) String() string {
 func (*UpdateDishNutritionInfoResponse) ProtoMessage() {}
 
 func (x *UpdateDishNutritionInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[36]
+	mi := &file_order_order_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +2805,7 @@ func (x *UpdateDishNutritionInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateDishNutritionInfoResponse.ProtoReflect.Descriptor instead.
 func (*UpdateDishNutritionInfoResponse) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{36}
+	return file_order_order_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UpdateDishNutritionInfoResponse) GetDish() *Dish {
@@ -2190,7 +2838,7 @@ type User struct {
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[37]
+		mi := &file_order_order_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2203,7 +2851,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[37]
+	mi := &file_order_order_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,7 +2864,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{37}
+	return file_order_order_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *User) GetId() string {
@@ -2331,7 +2979,7 @@ type Kitchen struct {
 func (x *Kitchen) Reset() {
 	*x = Kitchen{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[38]
+		mi := &file_order_order_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2344,7 +2992,7 @@ func (x *Kitchen) String() string {
 func (*Kitchen) ProtoMessage() {}
 
 func (x *Kitchen) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[38]
+	mi := &file_order_order_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2357,7 +3005,7 @@ func (x *Kitchen) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Kitchen.ProtoReflect.Descriptor instead.
 func (*Kitchen) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{38}
+	return file_order_order_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *Kitchen) GetId() string {
@@ -2460,7 +3108,7 @@ type Dish struct {
 func (x *Dish) Reset() {
 	*x = Dish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[39]
+		mi := &file_order_order_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2473,7 +3121,7 @@ func (x *Dish) String() string {
 func (*Dish) ProtoMessage() {}
 
 func (x *Dish) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[39]
+	mi := &file_order_order_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2486,7 +3134,7 @@ func (x *Dish) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dish.ProtoReflect.Descriptor instead.
 func (*Dish) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{39}
+	return file_order_order_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *Dish) GetId() string {
@@ -2540,80 +3188,218 @@ func (x *Dish) GetIngredients() []string {
 
 func (x *Dish) GetAllergens() []string {
 	if x != nil {
-		return x.Allergens
+		return x.Allergens
+	}
+	return nil
+}
+
+func (x *Dish) GetNutritionInfo() string {
+	if x != nil {
+		return x.NutritionInfo
+	}
+	return ""
+}
+
+func (x *Dish) GetDietaryInfo() []string {
+	if x != nil {
+		return x.DietaryInfo
+	}
+	return nil
+}
+
+func (x *Dish) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *Dish) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Dish) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId          string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	KitchenId       string               `protobuf:"bytes,3,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
+	Items           []*OrderItem         `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount     float64              `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Status          string               `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	DeliveryAddress string               `protobuf:"bytes,7,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	DeliveryTime    string               `protobuf:"bytes,8,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
+	CreatedAt       string               `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       string               `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DiscountCode    string               `protobuf:"bytes,11,opt,name=discount_code,json=discountCode,proto3" json:"discount_code,omitempty"`
+	PriceBreakdown  *PriceBreakdown      `protobuf:"bytes,12,opt,name=price_breakdown,json=priceBreakdown,proto3" json:"price_breakdown,omitempty"`
+	StatusHistory   []*OrderStatusChange `protobuf:"bytes,13,rep,name=status_history,json=statusHistory,proto3" json:"status_history,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Order) GetKitchenId() string {
+	if x != nil {
+		return x.KitchenId
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetDeliveryAddress() string {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return ""
+}
+
+func (x *Order) GetDeliveryTime() string {
+	if x != nil {
+		return x.DeliveryTime
 	}
-	return nil
+	return ""
 }
 
-func (x *Dish) GetNutritionInfo() string {
+func (x *Order) GetCreatedAt() string {
 	if x != nil {
-		return x.NutritionInfo
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Dish) GetDietaryInfo() []string {
+func (x *Order) GetUpdatedAt() string {
 	if x != nil {
-		return x.DietaryInfo
+		return x.UpdatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Dish) GetAvailable() bool {
+func (x *Order) GetDiscountCode() string {
 	if x != nil {
-		return x.Available
+		return x.DiscountCode
 	}
-	return false
+	return ""
 }
 
-func (x *Dish) GetCreatedAt() string {
+func (x *Order) GetPriceBreakdown() *PriceBreakdown {
 	if x != nil {
-		return x.CreatedAt
+		return x.PriceBreakdown
 	}
-	return ""
+	return nil
 }
 
-func (x *Dish) GetUpdatedAt() string {
+func (x *Order) GetStatusHistory() []*OrderStatusChange {
 	if x != nil {
-		return x.UpdatedAt
+		return x.StatusHistory
 	}
-	return ""
+	return nil
 }
 
-type Order struct {
+type OrderStatusChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          string       `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	KitchenId       string       `protobuf:"bytes,3,opt,name=kitchen_id,json=kitchenId,proto3" json:"kitchen_id,omitempty"`
-	Items           []*OrderItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount     float64      `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Status          string       `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	DeliveryAddress string       `protobuf:"bytes,7,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
-	DeliveryTime    string       `protobuf:"bytes,8,opt,name=delivery_time,json=deliveryTime,proto3" json:"delivery_time,omitempty"`
-	CreatedAt       string       `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       string       `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	FromStatus string `protobuf:"bytes,1,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus   string `protobuf:"bytes,2,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	ActorId    string `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt  string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[40]
+		mi := &file_order_order_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order) String() string {
+func (x *OrderStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*OrderStatusChange) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[40]
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2624,79 +3410,123 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *Order) GetId() string {
+func (x *OrderStatusChange) GetFromStatus() string {
 	if x != nil {
-		return x.Id
+		return x.FromStatus
 	}
 	return ""
 }
 
-func (x *Order) GetUserId() string {
+func (x *OrderStatusChange) GetToStatus() string {
 	if x != nil {
-		return x.UserId
+		return x.ToStatus
 	}
 	return ""
 }
 
-func (x *Order) GetKitchenId() string {
+func (x *OrderStatusChange) GetActorId() string {
 	if x != nil {
-		return x.KitchenId
+		return x.ActorId
 	}
 	return ""
 }
 
-func (x *Order) GetItems() []*OrderItem {
+func (x *OrderStatusChange) GetReason() string {
 	if x != nil {
-		return x.Items
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *Order) GetTotalAmount() float64 {
+func (x *OrderStatusChange) GetCreatedAt() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *Order) GetStatus() string {
+type PriceBreakdown struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subtotal    float64 `protobuf:"fixed64,1,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	DeliveryFee float64 `protobuf:"fixed64,2,opt,name=delivery_fee,json=deliveryFee,proto3" json:"delivery_fee,omitempty"`
+	Tax         float64 `protobuf:"fixed64,3,opt,name=tax,proto3" json:"tax,omitempty"`
+	Discount    float64 `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Total       float64 `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *PriceBreakdown) Reset() {
+	*x = PriceBreakdown{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceBreakdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceBreakdown) ProtoMessage() {}
+
+func (x *PriceBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceBreakdown.ProtoReflect.Descriptor instead.
+func (*PriceBreakdown) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PriceBreakdown) GetSubtotal() float64 {
 	if x != nil {
-		return x.Status
+		return x.Subtotal
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetDeliveryAddress() string {
+func (x *PriceBreakdown) GetDeliveryFee() float64 {
 	if x != nil {
-		return x.DeliveryAddress
+		return x.DeliveryFee
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetDeliveryTime() string {
+func (x *PriceBreakdown) GetTax() float64 {
 	if x != nil {
-		return x.DeliveryTime
+		return x.Tax
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetCreatedAt() string {
+func (x *PriceBreakdown) GetDiscount() float64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Discount
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetUpdatedAt() string {
+func (x *PriceBreakdown) GetTotal() float64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
 type OrderItem struct {
@@ -2713,7 +3543,7 @@ type OrderItem struct {
 func (x *OrderItem) Reset() {
 	*x = OrderItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[41]
+		mi := &file_order_order_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2726,7 +3556,7 @@ func (x *OrderItem) String() string {
 func (*OrderItem) ProtoMessage() {}
 
 func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[41]
+	mi := &file_order_order_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2739,7 +3569,7 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
 func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{41}
+	return file_order_order_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *OrderItem) GetDishId() string {
@@ -2787,7 +3617,7 @@ type Review struct {
 func (x *Review) Reset() {
 	*x = Review{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[42]
+		mi := &file_order_order_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2797,106 +3627,275 @@ func (x *Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Review) ProtoMessage() {}
-
-func (x *Review) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Review) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Review) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Review) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Review) GetKitchenId() string {
+	if x != nil {
+		return x.KitchenId
+	}
+	return ""
+}
+
+func (x *Review) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CardNumber          string    `protobuf:"bytes,8,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
+	OrderId             string    `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount              float64   `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status              string    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PaymentMethod       string    `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	TransactionId       string    `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt           string    `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RefundedAmount      float64   `protobuf:"fixed64,9,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	RefundTransactionId string    `protobuf:"bytes,10,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	CardToken           string    `protobuf:"bytes,11,opt,name=card_token,json=cardToken,proto3" json:"card_token,omitempty"`
+	CardLast4           string    `protobuf:"bytes,12,opt,name=card_last4,json=cardLast4,proto3" json:"card_last4,omitempty"`
+	CardBrand           string    `protobuf:"bytes,13,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
+	Provider            string    `protobuf:"bytes,14,opt,name=provider,proto3" json:"provider,omitempty"`
+	UpdatedAt           string    `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Refunds             []*Refund `protobuf:"bytes,16,rep,name=refunds,proto3" json:"refunds,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_order_order_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Payment) GetCardNumber() string {
+	if x != nil {
+		return x.CardNumber
+	}
+	return ""
+}
+
+func (x *Payment) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *Payment) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Payment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Review.ProtoReflect.Descriptor instead.
-func (*Review) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{42}
+func (x *Payment) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
 }
 
-func (x *Review) GetId() string {
+func (x *Payment) GetRefundTransactionId() string {
 	if x != nil {
-		return x.Id
+		return x.RefundTransactionId
 	}
 	return ""
 }
 
-func (x *Review) GetOrderId() string {
+func (x *Payment) GetCardToken() string {
 	if x != nil {
-		return x.OrderId
+		return x.CardToken
 	}
 	return ""
 }
 
-func (x *Review) GetUserId() string {
+func (x *Payment) GetCardLast4() string {
 	if x != nil {
-		return x.UserId
+		return x.CardLast4
 	}
 	return ""
 }
 
-func (x *Review) GetKitchenId() string {
+func (x *Payment) GetCardBrand() string {
 	if x != nil {
-		return x.KitchenId
+		return x.CardBrand
 	}
 	return ""
 }
 
-func (x *Review) GetRating() float32 {
+func (x *Payment) GetProvider() string {
 	if x != nil {
-		return x.Rating
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *Review) GetComment() string {
+func (x *Payment) GetUpdatedAt() string {
 	if x != nil {
-		return x.Comment
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-func (x *Review) GetCreatedAt() string {
+func (x *Payment) GetRefunds() []*Refund {
 	if x != nil {
-		return x.CreatedAt
+		return x.Refunds
 	}
-	return ""
+	return nil
 }
 
-type Payment struct {
+type Refund struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CardNumber    string  `protobuf:"bytes,8,opt,name=card_number,json=cardNumber,proto3" json:"card_number,omitempty"`
-	OrderId       string  `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Amount        float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status        string  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	PaymentMethod string  `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
-	TransactionId string  `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	CreatedAt     string  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id               string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PaymentId        string  `protobuf:"bytes,2,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	OrderId          string  `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Amount           float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	DishId           string  `protobuf:"bytes,5,opt,name=dish_id,json=dishId,proto3" json:"dish_id,omitempty"`
+	Quantity         int32   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason           string  `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProviderRefundId string  `protobuf:"bytes,8,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"`
+	ActorId          string  `protobuf:"bytes,9,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt        string  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Payment) Reset() {
-	*x = Payment{}
+func (x *Refund) Reset() {
+	*x = Refund{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[43]
+		mi := &file_order_order_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Payment) String() string {
+func (x *Refund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Payment) ProtoMessage() {}
+func (*Refund) ProtoMessage() {}
 
-func (x *Payment) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[43]
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_order_order_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2907,61 +3906,75 @@ func (x *Payment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
-func (*Payment) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_order_order_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Payment) GetId() string {
+func (x *Refund) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Payment) GetCardNumber() string {
+func (x *Refund) GetPaymentId() string {
 	if x != nil {
-		return x.CardNumber
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *Payment) GetOrderId() string {
+func (x *Refund) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *Payment) GetAmount() float64 {
+func (x *Refund) GetAmount() float64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *Payment) GetStatus() string {
+func (x *Refund) GetDishId() string {
 	if x != nil {
-		return x.Status
+		return x.DishId
 	}
 	return ""
 }
 
-func (x *Payment) GetPaymentMethod() string {
+func (x *Refund) GetQuantity() int32 {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Payment) GetTransactionId() string {
+func (x *Refund) GetProviderRefundId() string {
 	if x != nil {
-		return x.TransactionId
+		return x.ProviderRefundId
 	}
 	return ""
 }
 
-func (x *Payment) GetCreatedAt() string {
+func (x *Refund) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
@@ -2983,7 +3996,7 @@ type UserActivity struct {
 func (x *UserActivity) Reset() {
 	*x = UserActivity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_order_order_proto_msgTypes[44]
+		mi := &file_order_order_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2996,7 +4009,7 @@ func (x *UserActivity) String() string {
 func (*UserActivity) ProtoMessage() {}
 
 func (x *UserActivity) ProtoReflect() protoreflect.Message {
-	mi := &file_order_order_proto_msgTypes[44]
+	mi := &file_order_order_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,7 +4022,7 @@ func (x *UserActivity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserActivity.ProtoReflect.Descriptor instead.
 func (*UserActivity) Descriptor() ([]byte, []int) {
-	return file_order_order_proto_rawDescGZIP(), []int{44}
+	return file_order_order_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *UserActivity) GetOrderId() string {
@@ -3072,132 +4085,227 @@ var file_order_order_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44,
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x11, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x79, 0x0a, 0x12, 0x4c, 0x69, 0x73,
-	0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x23, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x68, 0x52, 0x06, 0x64, 0x69,
-	0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0x38, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x39,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x18, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x6d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63,
-	0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
-	0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x22, 0x59, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x11,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
+	0x6c, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x65, 0x74, 0x61, 0x72,
+	0x79, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x65, 0x74, 0x61, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x6c, 0x6c,
+	0x65, 0x72, 0x67, 0x65, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22,
+	0xa1, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44,
+	0x69, 0x73, 0x68, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x38, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x39, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x65, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x6d, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xac,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa2, 0x01,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65,
 	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4b, 0x69, 0x74, 0x63, 0x68,
 	0x65, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x05, 0x52, 0x04, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x36,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x3c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06,
-	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x22, 0x5d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69,
-	0x65, 0x77, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x40, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22,
-	0x38, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x1e, 0x47, 0x65, 0x74,
-	0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0f, 0x72,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73,
-	0x68, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x76, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4b,
-	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x47, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x63, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x07, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x22, 0x7c, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68,
 	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
-	0x22, 0xf5, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x2d, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x6f, 0x70,
-	0x44, 0x69, 0x73, 0x68, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x12,
-	0x37, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x42,
-	0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x0c, 0x62, 0x75, 0x73, 0x69,
-	0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x44,
+	0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0xcd, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x07, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x40, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x41, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x32, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69,
+	0x73, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73,
+	0x68, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x68, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x22, 0x38, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44,
+	0x69, 0x73, 0x68, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x76, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x22, 0xc3, 0x02, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4b, 0x69, 0x74, 0x63, 0x68, 0x65,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x5f, 0x64, 0x69, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x54,
+	0x6f, 0x70, 0x44, 0x69, 0x73, 0x68, 0x52, 0x09, 0x74, 0x6f, 0x70, 0x44, 0x69, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0d, 0x62, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x42, 0x75, 0x73, 0x69, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x52, 0x0c, 0x62, 0x75,
+	0x73, 0x69, 0x65, 0x73, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72,
+	0x6f, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x73, 0x73, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6a, 0x0a, 0x07, 0x54, 0x6f, 0x70, 0x44,
 	0x69, 0x73, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72, 0x64, 0x65, 0x72,
@@ -3343,7 +4451,7 @@ var file_order_order_proto_rawDesc = []byte{
 	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe6, 0x03, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65,
 	0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
 	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69,
@@ -3363,99 +4471,183 @@ var file_order_order_proto_rawDesc = []byte{
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6a, 0x0a, 0x09, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x68, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75,
-	0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x69, 0x65,
-	0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74, 0x63, 0x68,
-	0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf2, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a,
-	0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x69, 0x74, 0x63, 0x68, 0x65, 0x6e,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b, 0x69, 0x74,
-	0x63, 0x68, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0xf2, 0x09, 0x0a, 0x0c, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12, 0x18, 0x2e,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73,
-	0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
-	0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76,
-	0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x3e, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f,
+	0x77, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12,
+	0x3f, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x22, 0xa3, 0x01, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x75, 0x62,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x74, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x6a, 0x0a, 0x09,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xbc, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x69, 0x74,
+	0x63, 0x68, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x90, 0x04, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72,
+	0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x61, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
+	0x72, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x34, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72,
+	0x64, 0x42, 0x72, 0x61, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x06, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x73, 0x68, 0x49, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x9b, 0x01, 0x0a,
+	0x0c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x69, 0x74, 0x63, 0x68,
+	0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6b,
+	0x69, 0x74, 0x63, 0x68, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x32, 0x90, 0x0c, 0x0a, 0x0c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x12,
+	0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68,
+	0x65, 0x73, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x73, 0x12, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50,
 	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65,
 	0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
 	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
@@ -3503,7 +4695,7 @@ func file_order_order_proto_rawDescGZIP() []byte {
 	return file_order_order_proto_rawDescData
 }
 
-var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
 var file_order_order_proto_goTypes = []any{
 	(*CreateDishRequest)(nil),               // 0: order.CreateDishRequest
 	(*CreateDishResponse)(nil),              // 1: order.CreateDishResponse
@@ -3521,96 +4713,124 @@ var file_order_order_proto_goTypes = []any{
 	(*ListOrdersResponse)(nil),              // 13: order.ListOrdersResponse
 	(*GetOrderRequest)(nil),                 // 14: order.GetOrderRequest
 	(*GetOrderResponse)(nil),                // 15: order.GetOrderResponse
-	(*CreateReviewRequest)(nil),             // 16: order.CreateReviewRequest
-	(*CreateReviewResponse)(nil),            // 17: order.CreateReviewResponse
-	(*ListReviewsRequest)(nil),              // 18: order.ListReviewsRequest
-	(*ListReviewsResponse)(nil),             // 19: order.ListReviewsResponse
-	(*CreatePaymentRequest)(nil),            // 20: order.CreatePaymentRequest
-	(*CreatePaymentResponse)(nil),           // 21: order.CreatePaymentResponse
-	(*GetDishRecommendationsRequest)(nil),   // 22: order.GetDishRecommendationsRequest
-	(*GetDishRecommendationsResponse)(nil),  // 23: order.GetDishRecommendationsResponse
-	(*GetKitchenStatisticsRequest)(nil),     // 24: order.GetKitchenStatisticsRequest
-	(*GetKitchenStatisticsResponse)(nil),    // 25: order.GetKitchenStatisticsResponse
-	(*TopDish)(nil),                         // 26: order.TopDish
-	(*BusiestHour)(nil),                     // 27: order.BusiestHour
-	(*GetUserActivityRequest)(nil),          // 28: order.GetUserActivityRequest
-	(*GetUserActivityResponse)(nil),         // 29: order.GetUserActivityResponse
-	(*FavoriteCuisine)(nil),                 // 30: order.FavoriteCuisine
-	(*FavoriteKitchen)(nil),                 // 31: order.FavoriteKitchen
-	(*UpdateWorkingHoursRequest)(nil),       // 32: order.UpdateWorkingHoursRequest
-	(*WorkingHours)(nil),                    // 33: order.WorkingHours
-	(*UpdateWorkingHoursResponse)(nil),      // 34: order.UpdateWorkingHoursResponse
-	(*UpdateDishNutritionInfoRequest)(nil),  // 35: order.UpdateDishNutritionInfoRequest
-	(*UpdateDishNutritionInfoResponse)(nil), // 36: order.UpdateDishNutritionInfoResponse
-	(*User)(nil),                            // 37: order.User
-	(*Kitchen)(nil),                         // 38: order.Kitchen
-	(*Dish)(nil),                            // 39: order.Dish
-	(*Order)(nil),                           // 40: order.Order
-	(*OrderItem)(nil),                       // 41: order.OrderItem
-	(*Review)(nil),                          // 42: order.Review
-	(*Payment)(nil),                         // 43: order.Payment
-	(*UserActivity)(nil),                    // 44: order.UserActivity
+	(*CancelOrderRequest)(nil),              // 16: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),             // 17: order.CancelOrderResponse
+	(*CreateReviewRequest)(nil),             // 18: order.CreateReviewRequest
+	(*CreateReviewResponse)(nil),            // 19: order.CreateReviewResponse
+	(*ListReviewsRequest)(nil),              // 20: order.ListReviewsRequest
+	(*ListReviewsResponse)(nil),             // 21: order.ListReviewsResponse
+	(*CreatePaymentRequest)(nil),            // 22: order.CreatePaymentRequest
+	(*CreatePaymentResponse)(nil),           // 23: order.CreatePaymentResponse
+	(*GetPaymentRequest)(nil),               // 24: order.GetPaymentRequest
+	(*GetPaymentResponse)(nil),              // 25: order.GetPaymentResponse
+	(*ListPaymentsRequest)(nil),             // 26: order.ListPaymentsRequest
+	(*ListPaymentsResponse)(nil),            // 27: order.ListPaymentsResponse
+	(*RefundPaymentRequest)(nil),            // 28: order.RefundPaymentRequest
+	(*RefundPaymentResponse)(nil),           // 29: order.RefundPaymentResponse
+	(*GetDishRecommendationsRequest)(nil),   // 30: order.GetDishRecommendationsRequest
+	(*GetDishRecommendationsResponse)(nil),  // 31: order.GetDishRecommendationsResponse
+	(*GetKitchenStatisticsRequest)(nil),     // 32: order.GetKitchenStatisticsRequest
+	(*GetKitchenStatisticsResponse)(nil),    // 33: order.GetKitchenStatisticsResponse
+	(*TopDish)(nil),                         // 34: order.TopDish
+	(*BusiestHour)(nil),                     // 35: order.BusiestHour
+	(*GetUserActivityRequest)(nil),          // 36: order.GetUserActivityRequest
+	(*GetUserActivityResponse)(nil),         // 37: order.GetUserActivityResponse
+	(*FavoriteCuisine)(nil),                 // 38: order.FavoriteCuisine
+	(*FavoriteKitchen)(nil),                 // 39: order.FavoriteKitchen
+	(*UpdateWorkingHoursRequest)(nil),       // 40: order.UpdateWorkingHoursRequest
+	(*WorkingHours)(nil),                    // 41: order.WorkingHours
+	(*UpdateWorkingHoursResponse)(nil),      // 42: order.UpdateWorkingHoursResponse
+	(*UpdateDishNutritionInfoRequest)(nil),  // 43: order.UpdateDishNutritionInfoRequest
+	(*UpdateDishNutritionInfoResponse)(nil), // 44: order.UpdateDishNutritionInfoResponse
+	(*User)(nil),                            // 45: order.User
+	(*Kitchen)(nil),                         // 46: order.Kitchen
+	(*Dish)(nil),                            // 47: order.Dish
+	(*Order)(nil),                           // 48: order.Order
+	(*OrderStatusChange)(nil),               // 49: order.OrderStatusChange
+	(*PriceBreakdown)(nil),                  // 50: order.PriceBreakdown
+	(*OrderItem)(nil),                       // 51: order.OrderItem
+	(*Review)(nil),                          // 52: order.Review
+	(*Payment)(nil),                         // 53: order.Payment
+	(*Refund)(nil),                          // 54: order.Refund
+	(*UserActivity)(nil),                    // 55: order.UserActivity
 }
 var file_order_order_proto_depIdxs = []int32{
-	39, // 0: order.CreateDishRequest.dish:type_name -> order.Dish
-	39, // 1: order.CreateDishResponse.dish:type_name -> order.Dish
-	39, // 2: order.UpdateDishRequest.dish:type_name -> order.Dish
-	39, // 3: order.UpdateDishResponse.dish:type_name -> order.Dish
-	39, // 4: order.ListDishesResponse.dishes:type_name -> order.Dish
-	40, // 5: order.CreateOrderRequest.order:type_name -> order.Order
-	40, // 6: order.CreateOrderResponse.order:type_name -> order.Order
-	40, // 7: order.ListOrdersResponse.orders:type_name -> order.Order
-	40, // 8: order.GetOrderResponse.order:type_name -> order.Order
-	42, // 9: order.CreateReviewRequest.review:type_name -> order.Review
-	42, // 10: order.CreateReviewResponse.review:type_name -> order.Review
-	42, // 11: order.ListReviewsResponse.reviews:type_name -> order.Review
-	43, // 12: order.CreatePaymentRequest.payment:type_name -> order.Payment
-	43, // 13: order.CreatePaymentResponse.payment:type_name -> order.Payment
-	39, // 14: order.GetDishRecommendationsResponse.recommendations:type_name -> order.Dish
-	26, // 15: order.GetKitchenStatisticsResponse.top_dishes:type_name -> order.TopDish
-	27, // 16: order.GetKitchenStatisticsResponse.busiest_hours:type_name -> order.BusiestHour
-	44, // 17: order.GetUserActivityResponse.UserActivity:type_name -> order.UserActivity
-	33, // 18: order.UpdateWorkingHoursRequest.working_hours:type_name -> order.WorkingHours
-	33, // 19: order.UpdateWorkingHoursResponse.working_hours:type_name -> order.WorkingHours
-	39, // 20: order.UpdateDishNutritionInfoResponse.dish:type_name -> order.Dish
-	41, // 21: order.Order.items:type_name -> order.OrderItem
-	0,  // 22: order.OrderService.CreateDish:input_type -> order.CreateDishRequest
-	2,  // 23: order.OrderService.UpdateDish:input_type -> order.UpdateDishRequest
-	4,  // 24: order.OrderService.DeleteDish:input_type -> order.DeleteDishRequest
-	6,  // 25: order.OrderService.ListDishes:input_type -> order.ListDishesRequest
-	8,  // 26: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	10, // 27: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	12, // 28: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	14, // 29: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
-	16, // 30: order.OrderService.CreateReview:input_type -> order.CreateReviewRequest
-	18, // 31: order.OrderService.ListReviews:input_type -> order.ListReviewsRequest
-	20, // 32: order.OrderService.CreatePayment:input_type -> order.CreatePaymentRequest
-	22, // 33: order.OrderService.GetDishRecommendations:input_type -> order.GetDishRecommendationsRequest
-	24, // 34: order.OrderService.GetKitchenStatistics:input_type -> order.GetKitchenStatisticsRequest
-	28, // 35: order.OrderService.GetUserActivity:input_type -> order.GetUserActivityRequest
-	32, // 36: order.OrderService.UpdateWorkingHours:input_type -> order.UpdateWorkingHoursRequest
-	35, // 37: order.OrderService.UpdateDishNutritionInfo:input_type -> order.UpdateDishNutritionInfoRequest
-	1,  // 38: order.OrderService.CreateDish:output_type -> order.CreateDishResponse
-	3,  // 39: order.OrderService.UpdateDish:output_type -> order.UpdateDishResponse
-	5,  // 40: order.OrderService.DeleteDish:output_type -> order.DeleteDishResponse
-	7,  // 41: order.OrderService.ListDishes:output_type -> order.ListDishesResponse
-	9,  // 42: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	11, // 43: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	13, // 44: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	15, // 45: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
-	17, // 46: order.OrderService.CreateReview:output_type -> order.CreateReviewResponse
-	19, // 47: order.OrderService.ListReviews:output_type -> order.ListReviewsResponse
-	21, // 48: order.OrderService.CreatePayment:output_type -> order.CreatePaymentResponse
-	23, // 49: order.OrderService.GetDishRecommendations:output_type -> order.GetDishRecommendationsResponse
-	25, // 50: order.OrderService.GetKitchenStatistics:output_type -> order.GetKitchenStatisticsResponse
-	29, // 51: order.OrderService.GetUserActivity:output_type -> order.GetUserActivityResponse
-	34, // 52: order.OrderService.UpdateWorkingHours:output_type -> order.UpdateWorkingHoursResponse
-	36, // 53: order.OrderService.UpdateDishNutritionInfo:output_type -> order.UpdateDishNutritionInfoResponse
-	38, // [38:54] is the sub-list for method output_type
-	22, // [22:38] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	47, // 0: order.CreateDishRequest.dish:type_name -> order.Dish
+	47, // 1: order.CreateDishResponse.dish:type_name -> order.Dish
+	47, // 2: order.UpdateDishRequest.dish:type_name -> order.Dish
+	47, // 3: order.UpdateDishResponse.dish:type_name -> order.Dish
+	47, // 4: order.ListDishesResponse.dishes:type_name -> order.Dish
+	48, // 5: order.CreateOrderRequest.order:type_name -> order.Order
+	48, // 6: order.CreateOrderResponse.order:type_name -> order.Order
+	48, // 7: order.ListOrdersResponse.orders:type_name -> order.Order
+	48, // 8: order.GetOrderResponse.order:type_name -> order.Order
+	48, // 9: order.CancelOrderResponse.order:type_name -> order.Order
+	53, // 10: order.CancelOrderResponse.refunds:type_name -> order.Payment
+	52, // 11: order.CreateReviewRequest.review:type_name -> order.Review
+	52, // 12: order.CreateReviewResponse.review:type_name -> order.Review
+	52, // 13: order.ListReviewsResponse.reviews:type_name -> order.Review
+	53, // 14: order.CreatePaymentRequest.payment:type_name -> order.Payment
+	53, // 15: order.CreatePaymentResponse.payment:type_name -> order.Payment
+	53, // 16: order.GetPaymentResponse.payment:type_name -> order.Payment
+	53, // 17: order.ListPaymentsResponse.payments:type_name -> order.Payment
+	53, // 18: order.RefundPaymentResponse.payment:type_name -> order.Payment
+	54, // 19: order.RefundPaymentResponse.refund:type_name -> order.Refund
+	47, // 20: order.GetDishRecommendationsResponse.recommendations:type_name -> order.Dish
+	34, // 21: order.GetKitchenStatisticsResponse.top_dishes:type_name -> order.TopDish
+	35, // 22: order.GetKitchenStatisticsResponse.busiest_hours:type_name -> order.BusiestHour
+	55, // 23: order.GetUserActivityResponse.UserActivity:type_name -> order.UserActivity
+	41, // 24: order.UpdateWorkingHoursRequest.working_hours:type_name -> order.WorkingHours
+	41, // 25: order.UpdateWorkingHoursResponse.working_hours:type_name -> order.WorkingHours
+	47, // 26: order.UpdateDishNutritionInfoResponse.dish:type_name -> order.Dish
+	51, // 27: order.Order.items:type_name -> order.OrderItem
+	50, // 28: order.Order.price_breakdown:type_name -> order.PriceBreakdown
+	49, // 29: order.Order.status_history:type_name -> order.OrderStatusChange
+	54, // 30: order.Payment.refunds:type_name -> order.Refund
+	0,  // 31: order.OrderService.CreateDish:input_type -> order.CreateDishRequest
+	2,  // 32: order.OrderService.UpdateDish:input_type -> order.UpdateDishRequest
+	4,  // 33: order.OrderService.DeleteDish:input_type -> order.DeleteDishRequest
+	6,  // 34: order.OrderService.ListDishes:input_type -> order.ListDishesRequest
+	8,  // 35: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	10, // 36: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	12, // 37: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	14, // 38: order.OrderService.GetOrder:input_type -> order.GetOrderRequest
+	16, // 39: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	18, // 40: order.OrderService.CreateReview:input_type -> order.CreateReviewRequest
+	20, // 41: order.OrderService.ListReviews:input_type -> order.ListReviewsRequest
+	22, // 42: order.OrderService.CreatePayment:input_type -> order.CreatePaymentRequest
+	24, // 43: order.OrderService.GetPayment:input_type -> order.GetPaymentRequest
+	26, // 44: order.OrderService.ListPayments:input_type -> order.ListPaymentsRequest
+	28, // 45: order.OrderService.RefundPayment:input_type -> order.RefundPaymentRequest
+	30, // 46: order.OrderService.GetDishRecommendations:input_type -> order.GetDishRecommendationsRequest
+	32, // 47: order.OrderService.GetKitchenStatistics:input_type -> order.GetKitchenStatisticsRequest
+	36, // 48: order.OrderService.GetUserActivity:input_type -> order.GetUserActivityRequest
+	40, // 49: order.OrderService.UpdateWorkingHours:input_type -> order.UpdateWorkingHoursRequest
+	43, // 50: order.OrderService.UpdateDishNutritionInfo:input_type -> order.UpdateDishNutritionInfoRequest
+	1,  // 51: order.OrderService.CreateDish:output_type -> order.CreateDishResponse
+	3,  // 52: order.OrderService.UpdateDish:output_type -> order.UpdateDishResponse
+	5,  // 53: order.OrderService.DeleteDish:output_type -> order.DeleteDishResponse
+	7,  // 54: order.OrderService.ListDishes:output_type -> order.ListDishesResponse
+	9,  // 55: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	11, // 56: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	13, // 57: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	15, // 58: order.OrderService.GetOrder:output_type -> order.GetOrderResponse
+	17, // 59: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	19, // 60: order.OrderService.CreateReview:output_type -> order.CreateReviewResponse
+	21, // 61: order.OrderService.ListReviews:output_type -> order.ListReviewsResponse
+	23, // 62: order.OrderService.CreatePayment:output_type -> order.CreatePaymentResponse
+	25, // 63: order.OrderService.GetPayment:output_type -> order.GetPaymentResponse
+	27, // 64: order.OrderService.ListPayments:output_type -> order.ListPaymentsResponse
+	29, // 65: order.OrderService.RefundPayment:output_type -> order.RefundPaymentResponse
+	31, // 66: order.OrderService.GetDishRecommendations:output_type -> order.GetDishRecommendationsResponse
+	33, // 67: order.OrderService.GetKitchenStatistics:output_type -> order.GetKitchenStatisticsResponse
+	37, // 68: order.OrderService.GetUserActivity:output_type -> order.GetUserActivityResponse
+	42, // 69: order.OrderService.UpdateWorkingHours:output_type -> order.UpdateWorkingHoursResponse
+	44, // 70: order.OrderService.UpdateDishNutritionInfo:output_type -> order.UpdateDishNutritionInfoResponse
+	51, // [51:71] is the sub-list for method output_type
+	31, // [31:51] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_order_order_proto_init() }
@@ -3812,7 +5032,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateReviewRequest); i {
+			switch v := v.(*CancelOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3824,7 +5044,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateReviewResponse); i {
+			switch v := v.(*CancelOrderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3836,7 +5056,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ListReviewsRequest); i {
+			switch v := v.(*CreateReviewRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3848,7 +5068,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ListReviewsResponse); i {
+			switch v := v.(*CreateReviewResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3860,7 +5080,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePaymentRequest); i {
+			switch v := v.(*ListReviewsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3872,7 +5092,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*CreatePaymentResponse); i {
+			switch v := v.(*ListReviewsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3884,7 +5104,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDishRecommendationsRequest); i {
+			switch v := v.(*CreatePaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3896,7 +5116,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*GetDishRecommendationsResponse); i {
+			switch v := v.(*CreatePaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3908,7 +5128,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*GetKitchenStatisticsRequest); i {
+			switch v := v.(*GetPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3920,7 +5140,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*GetKitchenStatisticsResponse); i {
+			switch v := v.(*GetPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3932,7 +5152,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*TopDish); i {
+			switch v := v.(*ListPaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3944,7 +5164,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*BusiestHour); i {
+			switch v := v.(*ListPaymentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3956,7 +5176,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserActivityRequest); i {
+			switch v := v.(*RefundPaymentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3968,7 +5188,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUserActivityResponse); i {
+			switch v := v.(*RefundPaymentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3980,7 +5200,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*FavoriteCuisine); i {
+			switch v := v.(*GetDishRecommendationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3992,7 +5212,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*FavoriteKitchen); i {
+			switch v := v.(*GetDishRecommendationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4004,7 +5224,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWorkingHoursRequest); i {
+			switch v := v.(*GetKitchenStatisticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4016,7 +5236,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*WorkingHours); i {
+			switch v := v.(*GetKitchenStatisticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4028,7 +5248,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateWorkingHoursResponse); i {
+			switch v := v.(*TopDish); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4040,7 +5260,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDishNutritionInfoRequest); i {
+			switch v := v.(*BusiestHour); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4052,7 +5272,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateDishNutritionInfoResponse); i {
+			switch v := v.(*GetUserActivityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4064,7 +5284,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*User); i {
+			switch v := v.(*GetUserActivityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4076,7 +5296,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*Kitchen); i {
+			switch v := v.(*FavoriteCuisine); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4088,7 +5308,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*Dish); i {
+			switch v := v.(*FavoriteKitchen); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4100,7 +5320,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*Order); i {
+			switch v := v.(*UpdateWorkingHoursRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4112,7 +5332,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*OrderItem); i {
+			switch v := v.(*WorkingHours); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4124,7 +5344,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*Review); i {
+			switch v := v.(*UpdateWorkingHoursResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4136,7 +5356,7 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*Payment); i {
+			switch v := v.(*UpdateDishNutritionInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4148,6 +5368,138 @@ func file_order_order_proto_init() {
 			}
 		}
 		file_order_order_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateDishNutritionInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*Kitchen); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*Dish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderStatusChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceBreakdown); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*Review); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*Refund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_order_order_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*UserActivity); i {
 			case 0:
 				return &v.state
@@ -4166,7 +5518,7 @@ func file_order_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_order_order_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   56,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
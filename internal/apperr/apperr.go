@@ -0,0 +1,219 @@
+// Package apperr is the domain error layer between storage and the gRPC API.
+// Storage and domain packages return *Error values, or plain driver errors,
+// and UnaryInterceptor turns whatever reaches it into a status with a
+// fitting code. Callers never see driver or SQL text.
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Kind classifies an error independently of the transport.
+type Kind int
+
+const (
+	Internal Kind = iota
+	NotFound
+	Conflict
+	Validation
+	Forbidden
+	Precondition
+	Unavailable
+)
+
+var kindCodes = map[Kind]codes.Code{
+	Internal:     codes.Internal,
+	NotFound:     codes.NotFound,
+	Conflict:     codes.AlreadyExists,
+	Validation:   codes.InvalidArgument,
+	Forbidden:    codes.PermissionDenied,
+	Precondition: codes.FailedPrecondition,
+	Unavailable:  codes.Unavailable,
+}
+
+func (k Kind) Code() codes.Code {
+	return kindCodes[k]
+}
+
+// FieldViolation names a request field and what is wrong with it. Field is a
+// dotted path of proto field names, such as order.items.quantity.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error. Its message is safe to show to callers; Err, if
+// set, is the underlying cause and is only logged.
+type Error struct {
+	Kind       Kind
+	Message    string
+	Violations []FieldViolation
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func New(kind Kind, message string) *Error {
+	return &Error{Kind: kind, Message: message}
+}
+
+// Invalid is a validation error for a single field. The description doubles
+// as the error message.
+func Invalid(field, description string) *Error {
+	return &Error{Kind: Validation, Message: description, Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+// Wrap classifies cause with a safe message.
+func Wrap(kind Kind, message string, cause error) *Error {
+	return &Error{Kind: kind, Message: message, Err: cause}
+}
+
+// Postgres error codes, see https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pqUniqueViolation     = "23505"
+	pqForeignKeyViolation = "23503"
+	pqNotNullViolation    = "23502"
+	pqCheckViolation      = "23514"
+	pqInvalidText         = "22P02"
+	pqSerialization       = "40001"
+	pqDeadlock            = "40P01"
+	pqQueryCanceled       = "57014"
+)
+
+// keyColumns picks the column list out of a constraint violation detail such
+// as `Key (kitchen_id)=(...) is not present in table "kitchens".`
+var keyColumns = regexp.MustCompile(`^Key \(([^)]+)\)=`)
+
+// Classify turns any error into an *Error, recognising database/sql and
+// Postgres driver errors. Anything it does not recognise is Internal.
+func Classify(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		if err.Error() != e.Message {
+			// Keep the wrapping context, such as the id of the missing dish.
+			return &Error{Kind: e.Kind, Message: err.Error(), Violations: e.Violations, Err: err}
+		}
+		return e
+	}
+
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return Wrap(NotFound, "not found", err)
+	case errors.Is(err, sql.ErrConnDone), errors.Is(err, driver.ErrBadConn):
+		return Wrap(Unavailable, "storage is unavailable", err)
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return classifyPostgres(pqErr)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return Wrap(Unavailable, "storage is unavailable", err)
+	}
+	return Wrap(Internal, "internal error", err)
+}
+
+func classifyPostgres(err *pq.Error) *Error {
+	columns := constraintColumns(err)
+
+	switch string(err.Code) {
+	case pqUniqueViolation:
+		e := Wrap(Conflict, "resource already exists", err)
+		for _, column := range columns {
+			e.Violations = append(e.Violations, FieldViolation{Field: column, Description: "is already taken"})
+		}
+		return e
+	case pqForeignKeyViolation:
+		e := Wrap(Validation, "referenced resource does not exist", err)
+		for _, column := range columns {
+			e.Violations = append(e.Violations, FieldViolation{Field: column, Description: "does not reference an existing resource"})
+		}
+		return e
+	case pqNotNullViolation:
+		e := Wrap(Validation, "required field is missing", err)
+		if err.Column != "" {
+			e.Violations = []FieldViolation{{Field: err.Column, Description: "is required"}}
+		}
+		return e
+	case pqCheckViolation:
+		return Wrap(Validation, "value is out of range", err)
+	case pqInvalidText:
+		return Wrap(Validation, "malformed identifier or value", err)
+	case pqSerialization, pqDeadlock:
+		return Wrap(Unavailable, "request conflicted with a concurrent update, retry it", err)
+	case pqQueryCanceled:
+		return Wrap(Unavailable, "storage timed out", err)
+	}
+
+	switch err.Code.Class() {
+	case "08", "53", "57":
+		// Connection exceptions, insufficient resources and operator
+		// intervention such as a shutdown.
+		return Wrap(Unavailable, "storage is unavailable", err)
+	}
+	return Wrap(Internal, "internal error", err)
+}
+
+func constraintColumns(err *pq.Error) []string {
+	match := keyColumns.FindStringSubmatch(err.Detail)
+	if match == nil {
+		return nil
+	}
+	var columns []string
+	for _, column := range strings.Split(match[1], ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// Status converts err to a gRPC status. Status errors pass through unchanged
+// and context errors keep their own codes. Field violations are attached as
+// a google.rpc.BadRequest detail.
+func Status(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, "deadline exceeded")
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, "request canceled")
+	}
+
+	e := Classify(err)
+	s := status.New(e.Kind.Code(), e.Message)
+	if len(e.Violations) == 0 {
+		return s
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+	}
+	if detailed, err := s.WithDetails(badRequest); err == nil {
+		return detailed
+	}
+	return s
+}
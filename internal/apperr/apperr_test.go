@@ -0,0 +1,99 @@
+package apperr
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func violations(t *testing.T, s *status.Status) []*errdetails.BadRequest_FieldViolation {
+	t.Helper()
+	for _, detail := range s.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			return badRequest.FieldViolations
+		}
+	}
+	return nil
+}
+
+func TestStatus(t *testing.T) {
+	errDishNotFound := New(NotFound, "dish not found")
+	leak := `pq: relation "dishes" does not exist`
+
+	for _, tc := range []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+		fields  []string
+	}{
+		{"domain error", errDishNotFound, codes.NotFound, "dish not found", nil},
+		{"wrapped domain error", fmt.Errorf("%w: 42", errDishNotFound), codes.NotFound, "dish not found: 42", nil},
+		{"field violation", Invalid("order.items", "order has no items"), codes.InvalidArgument, "order has no items", []string{"order.items"}},
+		{"no rows", fmt.Errorf("scanning: %w", sql.ErrNoRows), codes.NotFound, "not found", nil},
+		{"unique violation", &pq.Error{Code: "23505", Message: "duplicate key value violates unique constraint \"users_email_key\"", Detail: "Key (email)=(a@b.c) already exists."},
+			codes.AlreadyExists, "resource already exists", []string{"email"}},
+		{"foreign key violation", &pq.Error{Code: "23503", Detail: `Key (kitchen_id, owner_id)=(1, 2) is not present in table "kitchens".`},
+			codes.InvalidArgument, "referenced resource does not exist", []string{"kitchen_id", "owner_id"}},
+		{"deadlock", &pq.Error{Code: "40P01"}, codes.Unavailable, "request conflicted with a concurrent update, retry it", nil},
+		{"connection failure", &pq.Error{Code: "08006"}, codes.Unavailable, "storage is unavailable", nil},
+		{"bad connection", fmt.Errorf("query: %w", sql.ErrConnDone), codes.Unavailable, "storage is unavailable", nil},
+		{"deadline", fmt.Errorf("query: %w", context.DeadlineExceeded), codes.DeadlineExceeded, "deadline exceeded", nil},
+		{"canceled", context.Canceled, codes.Canceled, "request canceled", nil},
+		{"status", status.Error(codes.PermissionDenied, "nope"), codes.PermissionDenied, "nope", nil},
+		{"unknown", errors.New(leak), codes.Internal, "internal error", nil},
+		{"unknown postgres error", &pq.Error{Code: "42P01", Message: leak}, codes.Internal, "internal error", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := Status(tc.err)
+			if s.Code() != tc.code || s.Message() != tc.message {
+				t.Fatalf("got %s %q, want %s %q", s.Code(), s.Message(), tc.code, tc.message)
+			}
+			got := violations(t, s)
+			if len(got) != len(tc.fields) {
+				t.Fatalf("got %d field violations, want %v", len(got), tc.fields)
+			}
+			for i, field := range tc.fields {
+				if got[i].Field != field {
+					t.Errorf("violation %d is for %s, want %s", i, got[i].Field, field)
+				}
+			}
+		})
+	}
+}
+
+func TestUnaryInterceptorHidesCauses(t *testing.T) {
+	dir := t.TempDir()
+	wd, _ := os.Getwd()
+	if err := os.Chdir(dir); err != nil { // NewLogger writes app.log to the working directory.
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/order.OrderService/ListDishes"}
+	cause := Wrap(Internal, "internal error", errors.New(`pq: syntax error at or near "selec"`))
+	_, err := UnaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, cause
+	})
+	s, ok := status.FromError(err)
+	if !ok || s.Code() != codes.Internal || strings.Contains(s.Message(), "syntax") {
+		t.Fatalf("interceptor returned %v", err)
+	}
+
+	resp, err := UnaryInterceptor(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || resp != "ok" {
+		t.Fatalf("successful call returned %v, %v", resp, err)
+	}
+}
@@ -0,0 +1,29 @@
+package apperr
+
+import (
+	"context"
+
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// UnaryInterceptor converts every error returned by the handler, or by the
+// interceptors after it, with Status. Internal and Unavailable errors are
+// logged with their cause, since callers only get a generic message. It
+// should be the first interceptor in the chain.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+
+	s := Status(err)
+	if code := s.Code(); code == codes.Internal || code == codes.Unavailable {
+		if log, logErr := l.NewLogger(); logErr == nil {
+			log.Error("request failed", zap.String("method", info.FullMethod), zap.String("code", code.String()), zap.Error(err))
+		}
+	}
+	return nil, s.Err()
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// PublicMethods can be called without a bearer token.
+var PublicMethods = map[string]bool{
+	authpb.AuthService_Register_FullMethodName:             true,
+	authpb.AuthService_Login_FullMethodName:                true,
+	authpb.AuthService_ResetPassword_FullMethodName:        true,
+	authpb.AuthService_ConfirmPasswordReset_FullMethodName: true,
+	authpb.AuthService_RefreshToken_FullMethodName:         true,
+	authpb.AuthService_GetKitchen_FullMethodName:           true,
+	authpb.AuthService_ListKitchens_FullMethodName:         true,
+	authpb.AuthService_SearchKitchens_FullMethodName:       true,
+	pb.OrderService_ListDishes_FullMethodName:              true,
+	pb.OrderService_ListReviews_FullMethodName:             true,
+}
+
+// UnaryInterceptor validates the bearer access token of every non-public RPC
+// and stores the caller's Principal on the context.
+func UnaryInterceptor(tokens *token.Manager, public map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if public[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		bearer, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "missing bearer token")
+		}
+
+		claims, err := tokens.ParseAccess(ctx, bearer)
+		if errors.Is(err, token.ErrInvalidToken) || errors.Is(err, token.ErrTokenRevoked) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "unable to validate token")
+		}
+
+		return handler(NewContext(ctx, &Principal{
+			UserID:   claims.Subject,
+			UserType: claims.UserType,
+			Claims:   claims,
+		}), req)
+	}
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	scheme, bearer, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || bearer == "" {
+		return "", false
+	}
+	return bearer, true
+}
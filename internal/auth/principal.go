@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+
+	"Github.com/LocalEats/Order-Service/internal/token"
+)
+
+const UserTypeAdmin = "admin"
+
+// Principal is the authenticated caller of an RPC.
+type Principal struct {
+	UserID   string
+	UserType string
+	Claims   *token.Claims
+}
+
+func (p *Principal) IsAdmin() bool {
+	return p.UserType == UserTypeAdmin
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func FromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(*Principal)
+	return p, ok
+}
@@ -3,6 +3,7 @@ package configs
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cast"
@@ -15,6 +16,33 @@ type Config struct {
 	DB_NAME     string
 	DB_PASSWORD string
 	URL_PORT    string
+
+	MIGRATE_ON_START bool
+
+	JWT_PRIVATE_KEY   string
+	ACCESS_TOKEN_TTL  time.Duration
+	REFRESH_TOKEN_TTL time.Duration
+
+	DELIVERY_FEE float64
+	TAX_RATE     float64
+
+	PAYMENT_PROVIDER string
+
+	NOTIFIER      string
+	SMTP_ADDR     string
+	SMTP_FROM     string
+	SMTP_USERNAME string
+	SMTP_PASSWORD string
+
+	IDEMPOTENCY_KEY_TTL time.Duration
+	IDEMPOTENCY_SECRET  string
+
+	WEBHOOK_PORT   string
+	WEBHOOK_SECRET string
+
+	GATEWAY_PORT string
+
+	PAGE_TOKEN_SECRET string
 }
 
 func Load() Config {
@@ -30,6 +58,33 @@ func Load() Config {
 	config.DB_PASSWORD = cast.ToString(Coalesce("DB_PASSWORD", "1111"))
 	config.URL_PORT = cast.ToString(Coalesce("URL_PORT", "50051"))
 
+	config.MIGRATE_ON_START = cast.ToBool(Coalesce("MIGRATE_ON_START", false))
+
+	config.JWT_PRIVATE_KEY = cast.ToString(Coalesce("JWT_PRIVATE_KEY", "keys/jwt.pem"))
+	config.ACCESS_TOKEN_TTL = cast.ToDuration(Coalesce("ACCESS_TOKEN_TTL", "15m"))
+	config.REFRESH_TOKEN_TTL = cast.ToDuration(Coalesce("REFRESH_TOKEN_TTL", "720h"))
+
+	config.DELIVERY_FEE = cast.ToFloat64(Coalesce("DELIVERY_FEE", 0))
+	config.TAX_RATE = cast.ToFloat64(Coalesce("TAX_RATE", 0))
+
+	config.PAYMENT_PROVIDER = cast.ToString(Coalesce("PAYMENT_PROVIDER", ""))
+
+	config.NOTIFIER = cast.ToString(Coalesce("NOTIFIER", ""))
+	config.SMTP_ADDR = cast.ToString(Coalesce("SMTP_ADDR", ""))
+	config.SMTP_FROM = cast.ToString(Coalesce("SMTP_FROM", ""))
+	config.SMTP_USERNAME = cast.ToString(Coalesce("SMTP_USERNAME", ""))
+	config.SMTP_PASSWORD = cast.ToString(Coalesce("SMTP_PASSWORD", ""))
+
+	config.IDEMPOTENCY_KEY_TTL = cast.ToDuration(Coalesce("IDEMPOTENCY_KEY_TTL", "24h"))
+	config.IDEMPOTENCY_SECRET = cast.ToString(Coalesce("IDEMPOTENCY_SECRET", ""))
+
+	config.WEBHOOK_PORT = cast.ToString(Coalesce("WEBHOOK_PORT", "8082"))
+	config.WEBHOOK_SECRET = cast.ToString(Coalesce("WEBHOOK_SECRET", ""))
+
+	config.GATEWAY_PORT = cast.ToString(Coalesce("GATEWAY_PORT", "8080"))
+
+	config.PAGE_TOKEN_SECRET = cast.ToString(Coalesce("PAGE_TOKEN_SECRET", ""))
+
 	return config
 }
 
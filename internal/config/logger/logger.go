@@ -26,7 +26,7 @@ func NewLogger() (*zap.Logger, error) {
 		EncodeDuration: zapcore.StringDurationEncoder,
 		EncodeCaller:   zapcore.ShortCallerEncoder,
 	}
-	encoder := zapcore.NewJSONEncoder(encoderConfig)
+	encoder := redactingEncoder{zapcore.NewJSONEncoder(encoderConfig)}
 
 	// Create core with file write syncer
 	fileWriteSyncer := zapcore.AddSync(logFile)
@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"net/http"
+
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+)
+
+// httpStatus follows the mapping used by grpc-gateway.
+var httpStatus = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusBadRequest,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+// HTTPStatus returns the HTTP status for a gRPC code.
+func HTTPStatus(code codes.Code) int {
+	if s, ok := httpStatus[code]; ok {
+		return s
+	}
+	return http.StatusInternalServerError
+}
+
+// writeError renders err as a google.rpc.Status JSON object. Errors that are
+// not statuses yet are classified by apperr, so driver text never reaches the
+// client even without apperr.UnaryInterceptor in the chain.
+func writeError(c *gin.Context, err error) {
+	st := apperr.Status(err)
+	body, marshalErr := marshal.Marshal(st.Proto())
+	if marshalErr != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.Data(HTTPStatus(st.Code()), "application/json", body)
+}
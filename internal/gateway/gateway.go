@@ -0,0 +1,198 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// forwardedHeaders are copied from the HTTP request into the gRPC metadata the
+// interceptors read.
+var forwardedHeaders = []string{"authorization", idempotency.MetadataKey}
+
+var (
+	unmarshal = protojson.UnmarshalOptions{DiscardUnknown: true}
+	marshal   = protojson.MarshalOptions{UseProtoNames: true}
+)
+
+// Route maps an HTTP method and path to an OrderService RPC. Path parameters
+// fill the request field of the same name unless Params maps them to another
+// field path. GET and DELETE requests take the remaining fields from the query
+// string, the others from a JSON body.
+type Route struct {
+	Method     string
+	Path       string
+	FullMethod string
+	Params     map[string]string
+	Request    protoreflect.MessageDescriptor
+	Response   protoreflect.MessageDescriptor
+
+	newRequest func() proto.Message
+	call       func(ctx context.Context, req proto.Message) (proto.Message, error)
+}
+
+// Gateway serves OrderService as a JSON API. Every call goes through the
+// same interceptors as the gRPC server.
+type Gateway struct {
+	Service     pb.OrderServiceServer
+	Interceptor grpc.UnaryServerInterceptor
+	routes      []Route
+}
+
+func New(service pb.OrderServiceServer, interceptors ...grpc.UnaryServerInterceptor) *Gateway {
+	return &Gateway{
+		Service:     service,
+		Interceptor: chain(interceptors),
+		routes:      orderRoutes(service),
+	}
+}
+
+func (g *Gateway) Routes() []Route {
+	return g.routes
+}
+
+// Register adds every route, the OpenAPI document and the docs page to r.
+func (g *Gateway) Register(r gin.IRouter) {
+	for _, route := range g.routes {
+		r.Handle(route.Method, route.Path, g.handler(route))
+	}
+	g.registerDocs(r)
+}
+
+func orderRoutes(s pb.OrderServiceServer) []Route {
+	return []Route{
+		route(http.MethodPost, "/kitchens/:kitchen_id/dishes", pb.OrderService_CreateDish_FullMethodName, s.CreateDish, map[string]string{"kitchen_id": "dish.kitchen_id"}),
+		route(http.MethodGet, "/kitchens/:kitchen_id/dishes", pb.OrderService_ListDishes_FullMethodName, s.ListDishes, nil),
+		route(http.MethodPut, "/dishes/:dish_id", pb.OrderService_UpdateDish_FullMethodName, s.UpdateDish, nil),
+		route(http.MethodDelete, "/dishes/:dish_id", pb.OrderService_DeleteDish_FullMethodName, s.DeleteDish, nil),
+		route(http.MethodPut, "/dishes/:dish_id/nutrition", pb.OrderService_UpdateDishNutritionInfo_FullMethodName, s.UpdateDishNutritionInfo, nil),
+
+		route(http.MethodPost, "/orders", pb.OrderService_CreateOrder_FullMethodName, s.CreateOrder, nil),
+		route(http.MethodGet, "/orders", pb.OrderService_ListOrders_FullMethodName, s.ListOrders, nil),
+		route(http.MethodGet, "/kitchens/:kitchen_id/orders", pb.OrderService_GetOrder_FullMethodName, s.GetOrder, map[string]string{"kitchen_id": "KitchenID"}),
+		route(http.MethodPut, "/orders/:order_id/status", pb.OrderService_UpdateOrderStatus_FullMethodName, s.UpdateOrderStatus, nil),
+		route(http.MethodPost, "/orders/:order_id/cancel", pb.OrderService_CancelOrder_FullMethodName, s.CancelOrder, nil),
+
+		route(http.MethodPost, "/orders/:order_id/reviews", pb.OrderService_CreateReview_FullMethodName, s.CreateReview, map[string]string{"order_id": "review.order_id"}),
+		route(http.MethodGet, "/kitchens/:kitchen_id/reviews", pb.OrderService_ListReviews_FullMethodName, s.ListReviews, nil),
+
+		route(http.MethodPost, "/orders/:order_id/payments", pb.OrderService_CreatePayment_FullMethodName, s.CreatePayment, map[string]string{"order_id": "payment.order_id"}),
+		route(http.MethodGet, "/payments", pb.OrderService_ListPayments_FullMethodName, s.ListPayments, nil),
+		route(http.MethodGet, "/payments/:payment_id", pb.OrderService_GetPayment_FullMethodName, s.GetPayment, nil),
+		route(http.MethodPost, "/payments/:payment_id/refunds", pb.OrderService_RefundPayment_FullMethodName, s.RefundPayment, nil),
+
+		route(http.MethodGet, "/users/:user_id/recommendations", pb.OrderService_GetDishRecommendations_FullMethodName, s.GetDishRecommendations, nil),
+		route(http.MethodGet, "/users/:user_id/activity", pb.OrderService_GetUserActivity_FullMethodName, s.GetUserActivity, nil),
+		route(http.MethodGet, "/kitchens/:kitchen_id/statistics", pb.OrderService_GetKitchenStatistics_FullMethodName, s.GetKitchenStatistics, nil),
+		route(http.MethodPut, "/kitchens/:kitchen_id/working-hours", pb.OrderService_UpdateWorkingHours_FullMethodName, s.UpdateWorkingHours, nil),
+	}
+}
+
+func route[Req, Resp proto.Message](method, path, fullMethod string, call func(context.Context, Req) (Resp, error), params map[string]string) Route {
+	var req Req
+	var resp Resp
+	return Route{
+		Method:     method,
+		Path:       path,
+		FullMethod: fullMethod,
+		Params:     params,
+		Request:    req.ProtoReflect().Descriptor(),
+		Response:   resp.ProtoReflect().Descriptor(),
+		newRequest: func() proto.Message { return req.ProtoReflect().New().Interface() },
+		call: func(ctx context.Context, r proto.Message) (proto.Message, error) {
+			return call(ctx, r.(Req))
+		},
+	}
+}
+
+func (g *Gateway) handler(route Route) gin.HandlerFunc {
+	info := &grpc.UnaryServerInfo{Server: g.Service, FullMethod: route.FullMethod}
+
+	return func(c *gin.Context) {
+		req := route.newRequest()
+		if err := bind(c, route, req); err != nil {
+			writeError(c, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		md := metadata.MD{}
+		for _, header := range forwardedHeaders {
+			if value := c.GetHeader(header); value != "" {
+				md.Set(header, value)
+			}
+		}
+		ctx := metadata.NewIncomingContext(c.Request.Context(), md)
+
+		resp, err := g.Interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return route.call(ctx, req.(proto.Message))
+		})
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+
+		body, err := marshal.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeError(c, err)
+			return
+		}
+		c.Data(http.StatusOK, "application/json", body)
+	}
+}
+
+func bind(c *gin.Context, route Route, req proto.Message) error {
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodDelete {
+		for key, values := range c.Request.URL.Query() {
+			if err := setField(req.ProtoReflect(), key, values); err != nil {
+				return err
+			}
+		}
+	} else {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, 1<<20))
+		if err != nil {
+			return err
+		}
+		if len(strings.TrimSpace(string(body))) > 0 {
+			if err := unmarshal.Unmarshal(body, req); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, param := range c.Params {
+		field := param.Key
+		if mapped, ok := route.Params[param.Key]; ok {
+			field = mapped
+		}
+		if err := setField(req.ProtoReflect(), field, []string{param.Value}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// chain runs interceptors in order, like grpc.ChainUnaryInterceptor.
+func chain(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, inner)
+			}
+		}
+		return next(ctx, req)
+	}
+}
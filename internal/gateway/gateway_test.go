@@ -0,0 +1,234 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+	"Github.com/LocalEats/Order-Service/internal/validate"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const kitchenID = "6f1c2a9e-3b7d-4c55-9a0e-2d8f41b7c3a1"
+
+// stubService records the request of every call it answers.
+type stubService struct {
+	pb.UnimplementedOrderServiceServer
+	mu    sync.Mutex
+	calls []any
+}
+
+func (s *stubService) record(req any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, req)
+}
+
+func (s *stubService) ListDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
+	s.record(req)
+	return &pb.ListDishesResponse{Dishes: []*pb.Dish{{Id: "dish-1", KitchenId: req.KitchenId, Name: "Soup"}}, Total: 1}, nil
+}
+
+func (s *stubService) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	s.record(req)
+	return &pb.CreatePaymentResponse{Payment: &pb.Payment{Id: "payment-1", OrderId: req.Payment.OrderId, Amount: req.Payment.Amount}}, nil
+}
+
+// recorder is an interceptor that notes its name and the incoming metadata
+// before passing the call on.
+type recorder struct {
+	mu    sync.Mutex
+	trace []string
+	md    metadata.MD
+}
+
+func (r *recorder) interceptor(name string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		r.mu.Lock()
+		r.trace = append(r.trace, name+" "+info.FullMethod)
+		r.md, _ = metadata.FromIncomingContext(ctx)
+		r.mu.Unlock()
+		return handler(ctx, req)
+	}
+}
+
+func serve(service pb.OrderServiceServer, interceptors ...grpc.UnaryServerInterceptor) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	New(service, interceptors...).Register(router)
+	return router
+}
+
+func do(router *gin.Engine, method, target, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestQueryParametersFillTheRequest(t *testing.T) {
+	service := &stubService{}
+	router := serve(service)
+
+	w := do(router, http.MethodGet, "/kitchens/"+kitchenID+"/dishes?category=soup&min_price=2.5&available_only=true&dietary_info=vegan&dietary_info=halal&page=2", "", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET: %d %s", w.Code, w.Body)
+	}
+	if len(service.calls) != 1 {
+		t.Fatalf("service called %d times", len(service.calls))
+	}
+	req := service.calls[0].(*pb.ListDishesRequest)
+	if req.KitchenId != kitchenID || req.Category != "soup" || req.MinPrice != 2.5 || !req.AvailableOnly || req.Page != 2 {
+		t.Errorf("request = %v", req)
+	}
+	if len(req.DietaryInfo) != 2 || req.DietaryInfo[0] != "vegan" || req.DietaryInfo[1] != "halal" {
+		t.Errorf("dietary_info = %v, want [vegan halal]", req.DietaryInfo)
+	}
+
+	resp := &pb.ListDishesResponse{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 1 || len(resp.Dishes) != 1 || resp.Dishes[0].KitchenId != kitchenID {
+		t.Errorf("response = %v", resp)
+	}
+	if !strings.Contains(w.Body.String(), `"kitchen_id"`) {
+		t.Errorf("response does not use proto field names: %s", w.Body)
+	}
+
+	if w := do(router, http.MethodGet, "/kitchens/"+kitchenID+"/dishes?min_price=cheap", "", nil); w.Code != http.StatusBadRequest {
+		t.Errorf("unparsable query parameter: %d %s", w.Code, w.Body)
+	}
+}
+
+func TestBodyAndPathParametersFillTheRequest(t *testing.T) {
+	service := &stubService{}
+	router := serve(service)
+
+	w := do(router, http.MethodPost, "/orders/order-1/payments", `{"payment": {"amount": 12.5, "payment_method": "card", "order_id": "ignored"}}`, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("POST: %d %s", w.Code, w.Body)
+	}
+	req := service.calls[0].(*pb.CreatePaymentRequest)
+	// The path parameter is mapped to payment.order_id and wins over the body.
+	if req.Payment.OrderId != "order-1" || req.Payment.Amount != 12.5 || req.Payment.PaymentMethod != "card" {
+		t.Errorf("request = %v", req)
+	}
+
+	if w := do(router, http.MethodPost, "/orders/order-1/payments", `{"payment":`, nil); w.Code != http.StatusBadRequest {
+		t.Errorf("malformed body: %d %s", w.Code, w.Body)
+	}
+}
+
+func TestHeadersAreForwardedThroughTheChainInOrder(t *testing.T) {
+	service := &stubService{}
+	rec := &recorder{}
+	router := serve(service, rec.interceptor("first"), rec.interceptor("second"))
+
+	w := do(router, http.MethodPost, "/orders/order-1/payments", `{"payment": {"amount": 1, "payment_method": "card"}}`, map[string]string{
+		"Authorization":   "Bearer access-token",
+		"Idempotency-Key": "key-1",
+		"X-Other":         "dropped",
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("POST: %d %s", w.Code, w.Body)
+	}
+
+	method := pb.OrderService_CreatePayment_FullMethodName
+	if want := []string{"first " + method, "second " + method}; strings.Join(rec.trace, ",") != strings.Join(want, ",") {
+		t.Errorf("chain ran %v, want %v", rec.trace, want)
+	}
+	if got := rec.md.Get("authorization"); len(got) != 1 || got[0] != "Bearer access-token" {
+		t.Errorf("authorization = %v", got)
+	}
+	if got := rec.md.Get(idempotency.MetadataKey); len(got) != 1 || got[0] != "key-1" {
+		t.Errorf("%s = %v", idempotency.MetadataKey, got)
+	}
+	if got := rec.md.Get("x-other"); len(got) != 0 {
+		t.Errorf("x-other was forwarded: %v", got)
+	}
+}
+
+func TestUnauthenticatedCallsGet401(t *testing.T) {
+	service := &stubService{}
+	// The token manager is only consulted once a bearer token is present.
+	router := serve(service, apperr.UnaryInterceptor, auth.UnaryInterceptor(nil, auth.PublicMethods))
+
+	w := do(router, http.MethodPost, "/orders/order-1/payments", `{"payment": {"amount": 1, "payment_method": "card"}}`, nil)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("POST without a token: %d %s", w.Code, w.Body)
+	}
+	if len(service.calls) != 0 {
+		t.Fatal("service was called without a token")
+	}
+	st := &spb.Status{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), st); err != nil {
+		t.Fatal(err)
+	}
+	if codes.Code(st.Code) != codes.Unauthenticated {
+		t.Errorf("status = %v", st)
+	}
+
+	// ListDishes is public.
+	if w := do(router, http.MethodGet, "/kitchens/"+kitchenID+"/dishes", "", nil); w.Code != http.StatusOK {
+		t.Errorf("public GET without a token: %d %s", w.Code, w.Body)
+	}
+}
+
+func TestInvalidRequestsGet400WithFieldViolations(t *testing.T) {
+	service := &stubService{}
+	router := serve(service, apperr.UnaryInterceptor, validate.UnaryInterceptor(validate.OrderRules))
+
+	w := do(router, http.MethodGet, "/kitchens/not-a-uuid/dishes?sort_by=random", "", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("GET: %d %s", w.Code, w.Body)
+	}
+	if len(service.calls) != 0 {
+		t.Fatal("service was called with an invalid request")
+	}
+
+	var body struct {
+		Code    int               `json:"code"`
+		Details []json.RawMessage `json:"details"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if codes.Code(body.Code) != codes.InvalidArgument || len(body.Details) != 1 {
+		t.Fatalf("error body = %s", w.Body)
+	}
+
+	// Decoding the whole status resolves the detail's @type.
+	st := &spb.Status{}
+	if err := protojson.Unmarshal(w.Body.Bytes(), st); err != nil {
+		t.Fatal(err)
+	}
+	badRequest := &errdetails.BadRequest{}
+	if err := st.Details[0].UnmarshalTo(badRequest); err != nil {
+		t.Fatal(err)
+	}
+	fields := map[string]bool{}
+	for _, v := range badRequest.FieldViolations {
+		fields[v.Field] = true
+	}
+	if len(fields) != 2 || !fields["kitchen_id"] || !fields["sort_by"] {
+		t.Errorf("field violations = %v", badRequest.FieldViolations)
+	}
+}
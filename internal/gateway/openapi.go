@@ -0,0 +1,261 @@
+package gateway
+
+import (
+	_ "embed"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	SpecPath = "/openapi.json"
+	DocsPath = "/docs"
+
+	statusSchema = "google.rpc.Status"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+// schemaFiles are the proto files whose messages the spec describes.
+var schemaFiles = []protoreflect.FileDescriptor{pb.File_order_order_proto, authpb.File_auth_auth_proto}
+
+var pathParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// OpenAPIPath turns a gin path such as /orders/:order_id into /orders/{order_id}.
+func OpenAPIPath(path string) string {
+	return pathParam.ReplaceAllString(path, "{$1}")
+}
+
+// OpenAPI builds an OpenAPI 3 document for the gateway's routes. Schemas are
+// generated from the order and auth proto messages and follow protojson, with
+// the proto field names the gateway emits.
+func (g *Gateway) OpenAPI() map[string]any {
+	paths := map[string]map[string]any{}
+	for _, route := range g.routes {
+		path := OpenAPIPath(route.Path)
+		if paths[path] == nil {
+			paths[path] = map[string]any{}
+		}
+		paths[path][strings.ToLower(route.Method)] = operation(route)
+	}
+
+	paths[SpecPath] = map[string]any{"get": map[string]any{
+		"operationId": "OpenAPI",
+		"summary":     "This document.",
+		"tags":        []string{"Docs"},
+		"security":    []any{},
+		"responses": map[string]any{
+			"200": content("application/json", map[string]any{"type": "object"}),
+		},
+	}}
+	paths[DocsPath] = map[string]any{"get": map[string]any{
+		"operationId": "Docs",
+		"summary":     "Browsable documentation for this API.",
+		"tags":        []string{"Docs"},
+		"security":    []any{},
+		"responses": map[string]any{
+			"200": content("text/html", map[string]any{"type": "string"}),
+		},
+	}}
+
+	schemas := map[string]any{statusSchema: map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"code":    map[string]any{"type": "integer", "format": "int32"},
+			"message": map[string]any{"type": "string"},
+			"details": map[string]any{"type": "array", "items": map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"@type": map[string]any{"type": "string"}},
+				"additionalProperties": true,
+			}},
+		},
+	}}
+	for _, file := range schemaFiles {
+		addSchemas(schemas, file.Messages())
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":       "LocalEats Order Service",
+			"version":     "1.0.0",
+			"description": "JSON gateway over the OrderService gRPC API. Errors are google.rpc.Status objects.",
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"bearer": map[string]any{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []any{map[string]any{"bearer": []string{}}},
+	}
+}
+
+func operation(route Route) map[string]any {
+	name := route.FullMethod[strings.LastIndex(route.FullMethod, "/")+1:]
+	op := map[string]any{
+		"operationId": name,
+		"tags":        []string{string(route.Request.ParentFile().Services().Get(0).Name())},
+		"responses": map[string]any{
+			"200":     content("application/json", ref(route.Response)),
+			"default": content("application/json", map[string]any{"$ref": "#/components/schemas/" + statusSchema}),
+		},
+	}
+	if auth.PublicMethods[route.FullMethod] {
+		op["security"] = []any{}
+	}
+
+	bound := map[string]bool{}
+	var parameters []any
+	for _, match := range pathParam.FindAllStringSubmatch(route.Path, -1) {
+		field := match[1]
+		if mapped, ok := route.Params[field]; ok {
+			field = mapped
+		}
+		bound[field] = true
+		parameters = append(parameters, map[string]any{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   fieldSchema(fieldAt(route.Request, field)),
+		})
+	}
+	if _, ok := idempotency.Methods[route.FullMethod]; ok {
+		parameters = append(parameters, map[string]any{
+			"name":        idempotency.MetadataKey,
+			"in":          "header",
+			"description": "Retries with the same key replay the first response.",
+			"schema":      map[string]any{"type": "string"},
+		})
+	}
+
+	if route.Method == http.MethodGet || route.Method == http.MethodDelete {
+		fields := route.Request.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if bound[string(fd.Name())] || fd.Kind() == protoreflect.MessageKind || fd.IsMap() {
+				continue
+			}
+			parameters = append(parameters, map[string]any{
+				"name":   string(fd.Name()),
+				"in":     "query",
+				"schema": fieldSchema(fd),
+			})
+		}
+	} else {
+		op["requestBody"] = map[string]any{
+			"required": true,
+			"content":  map[string]any{"application/json": map[string]any{"schema": ref(route.Request)}},
+		}
+	}
+	if len(parameters) > 0 {
+		op["parameters"] = parameters
+	}
+	return op
+}
+
+// fieldAt resolves a dotted field path such as dish.kitchen_id.
+func fieldAt(md protoreflect.MessageDescriptor, path string) protoreflect.FieldDescriptor {
+	var fd protoreflect.FieldDescriptor
+	for _, name := range strings.Split(path, ".") {
+		fd = lookupField(md, name)
+		if fd == nil {
+			return nil
+		}
+		md = fd.Message()
+	}
+	return fd
+}
+
+func content(mediaType string, schema map[string]any) map[string]any {
+	return map[string]any{
+		"description": "OK",
+		"content":     map[string]any{mediaType: map[string]any{"schema": schema}},
+	}
+}
+
+func ref(md protoreflect.MessageDescriptor) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + string(md.FullName())}
+}
+
+func addSchemas(schemas map[string]any, messages protoreflect.MessageDescriptors) {
+	for i := 0; i < messages.Len(); i++ {
+		md := messages.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+
+		properties := map[string]any{}
+		fields := md.Fields()
+		for j := 0; j < fields.Len(); j++ {
+			properties[string(fields.Get(j).Name())] = fieldSchema(fields.Get(j))
+		}
+		schemas[string(md.FullName())] = map[string]any{"type": "object", "properties": properties}
+		addSchemas(schemas, md.Messages())
+	}
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	if fd == nil {
+		return map[string]any{"type": "string"}
+	}
+	if fd.IsMap() {
+		return map[string]any{"type": "object", "additionalProperties": scalarSchema(fd.MapValue())}
+	}
+	if fd.IsList() {
+		return map[string]any{"type": "array", "items": scalarSchema(fd)}
+	}
+	return scalarSchema(fd)
+}
+
+// scalarSchema describes one value of the field as protojson encodes it.
+func scalarSchema(fd protoreflect.FieldDescriptor) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return ref(fd.Message())
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int64", "minimum": 0}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		var names []string
+		values := fd.Enum().Values()
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		sort.Strings(names)
+		return map[string]any{"type": "string", "enum": names}
+	}
+	return map[string]any{"type": "string"}
+}
+
+func (g *Gateway) registerDocs(r gin.IRouter) {
+	spec := g.OpenAPI()
+	r.GET(SpecPath, func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	})
+	r.GET(DocsPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "text/html; charset=utf-8", docsPage)
+	})
+}
@@ -0,0 +1,144 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"github.com/gin-gonic/gin"
+)
+
+func newRouter() (*gin.Engine, *Gateway) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	gateway := New(pb.UnimplementedOrderServiceServer{})
+	gateway.Register(router)
+	return router, gateway
+}
+
+// servedSpec fetches the document the way a client would, so the test checks
+// the JSON that is actually served.
+func servedSpec(t *testing.T, router *gin.Engine) map[string]any {
+	t.Helper()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, SpecPath, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET %s: %d", SpecPath, w.Code)
+	}
+	var spec map[string]any
+	if err := json.Unmarshal(w.Body.Bytes(), &spec); err != nil {
+		t.Fatalf("decoding spec: %v", err)
+	}
+	return spec
+}
+
+func TestEveryRouteIsDocumented(t *testing.T) {
+	router, _ := newRouter()
+	spec := servedSpec(t, router)
+	paths := spec["paths"].(map[string]any)
+	schemas := spec["components"].(map[string]any)["schemas"].(map[string]any)
+
+	documented := 0
+	for _, route := range router.Routes() {
+		name := route.Method + " " + route.Path
+
+		item, ok := paths[OpenAPIPath(route.Path)].(map[string]any)
+		if !ok {
+			t.Errorf("%s: path is not in the spec", name)
+			continue
+		}
+		op, ok := item[strings.ToLower(route.Method)].(map[string]any)
+		if !ok {
+			t.Errorf("%s: method is not in the spec", name)
+			continue
+		}
+		documented++
+
+		responses, _ := op["responses"].(map[string]any)
+		ok200, _ := responses["200"].(map[string]any)
+		if ok200 == nil || ok200["content"] == nil {
+			t.Errorf("%s: no documented 200 response", name)
+		}
+		if route.Method == http.MethodPost || route.Method == http.MethodPut {
+			if op["requestBody"] == nil {
+				t.Errorf("%s: no documented request body", name)
+			}
+		}
+		for _, match := range pathParam.FindAllStringSubmatch(route.Path, -1) {
+			if !hasParameter(op, match[1], "path") {
+				t.Errorf("%s: path parameter %s is not documented", name, match[1])
+			}
+		}
+		checkRefs(t, name, op, schemas)
+	}
+
+	if documented == 0 {
+		t.Fatal("no routes registered")
+	}
+	for name, schema := range schemas {
+		checkRefs(t, "schema "+name, schema, schemas)
+	}
+}
+
+func TestEveryRPCHasARoute(t *testing.T) {
+	_, gateway := newRouter()
+	routed := map[string]bool{}
+	for _, route := range gateway.Routes() {
+		routed[route.FullMethod] = true
+	}
+
+	methods := pb.File_order_order_proto.Services().ByName("OrderService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		fullMethod := "/order.OrderService/" + string(methods.Get(i).Name())
+		if !routed[fullMethod] {
+			t.Errorf("%s has no HTTP route", fullMethod)
+		}
+	}
+}
+
+func TestDocsPage(t *testing.T) {
+	router, _ := newRouter()
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, DocsPath, nil))
+	if w.Code != http.StatusOK || !strings.Contains(w.Header().Get("Content-Type"), "text/html") {
+		t.Fatalf("GET %s: %d %s", DocsPath, w.Code, w.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(w.Body.String(), "openapi.json") {
+		t.Fatal("docs page does not load the spec")
+	}
+}
+
+func hasParameter(op map[string]any, name, in string) bool {
+	parameters, _ := op["parameters"].([]any)
+	for _, p := range parameters {
+		param := p.(map[string]any)
+		if param["name"] == name && param["in"] == in {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRefs fails for every $ref under node that names a missing schema.
+func checkRefs(t *testing.T, where string, node any, schemas map[string]any) {
+	t.Helper()
+	switch v := node.(type) {
+	case map[string]any:
+		if ref, ok := v["$ref"].(string); ok {
+			name := strings.TrimPrefix(ref, "#/components/schemas/")
+			if _, ok := schemas[name]; !ok {
+				t.Errorf("%s: undefined schema %s", where, ref)
+			}
+		}
+		for _, child := range v {
+			checkRefs(t, where, child, schemas)
+		}
+	case []any:
+		for _, child := range v {
+			checkRefs(t, where, child, schemas)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetadataKey is the gRPC metadata header clients put the key in.
+const MetadataKey = "idempotency-key"
+
+const maxKeyLength = 255
+
+// Lease is how long a request holds its key while it is being handled. A key
+// still in progress after its lease, because the server handling the request
+// went away, can be taken over by a retry.
+const Lease = time.Minute
+
+// Record is a stored key. Response is nil while the first request is still
+// being handled.
+type Record struct {
+	Fingerprint string
+	Response    []byte
+}
+
+// Store persists idempotency keys per user and method. Reserve claims the key
+// for owner until leaseUntil and returns nil, or returns the unexpired record
+// already holding it. A key in progress past its lease is claimed by a request
+// with the same fingerprint. Complete and Release only act on the key while
+// owner still holds it, so a request that lost its key to a retry cannot
+// overwrite or drop the retry's reservation.
+type Store interface {
+	Reserve(ctx context.Context, userID, method, key, owner, fingerprint string, expiresAt, leaseUntil time.Time) (*Record, error)
+	Complete(ctx context.Context, userID, method, key, owner string, response []byte) error
+	Release(ctx context.Context, userID, method, key, owner string) error
+}
+
+// ErrNotOwner is returned by Complete when the key has been taken over.
+var ErrNotOwner = errors.New("idempotency key is held by another request")
+
+// Methods are the RPCs that honour idempotency keys, with a constructor for
+// the response type used to decode stored responses.
+var Methods = map[string]func() proto.Message{
+	pb.OrderService_CreateOrder_FullMethodName:   func() proto.Message { return &pb.CreateOrderResponse{} },
+	pb.OrderService_CreatePayment_FullMethodName: func() proto.Message { return &pb.CreatePaymentResponse{} },
+}
+
+// UnaryInterceptor makes the given methods safe to retry. The first request
+// with a key runs normally and its response is stored; replays with the same
+// payload get the stored response, and replays with a different payload fail
+// with AlreadyExists. Failed requests release the key so they can be retried.
+// It must run after auth.UnaryInterceptor and before anything that rewrites
+// the request, such as payment.TokenizeCards, so the fingerprint covers what
+// the client sent. Requests are fingerprinted with secret; see Fingerprint.
+func UnaryInterceptor(store Store, secret []byte, ttl time.Duration, methods map[string]func() proto.Message) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		newResponse, ok := methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		key := keyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+		if len(key) > maxKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be at most %d characters", MetadataKey, maxKeyLength)
+		}
+
+		caller, ok := auth.FromContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+		}
+
+		fingerprint, err := Fingerprint(secret, req.(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+
+		owner := uuid.NewString()
+		now := time.Now()
+		existing, err := store.Reserve(ctx, caller.UserID, info.FullMethod, key, owner, fingerprint, now.Add(ttl), now.Add(Lease))
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			if existing.Fingerprint != fingerprint {
+				return nil, status.Error(codes.AlreadyExists, "idempotency key was already used with a different request")
+			}
+			if existing.Response == nil {
+				return nil, status.Error(codes.Aborted, "a request with this idempotency key is still in progress")
+			}
+			resp := newResponse()
+			if err := proto.Unmarshal(existing.Response, resp); err != nil {
+				return nil, err
+			}
+			return resp, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			store.Release(context.WithoutCancel(ctx), caller.UserID, info.FullMethod, key, owner)
+			return nil, err
+		}
+
+		// The request has taken effect, so its response is returned even if it
+		// cannot be stored; a replay then waits out the lease and runs again.
+		body, err := proto.Marshal(resp.(proto.Message))
+		if err == nil {
+			err = store.Complete(context.WithoutCancel(ctx), caller.UserID, info.FullMethod, key, owner, body)
+		}
+		if err != nil {
+			if log, logErr := l.NewLogger(); logErr == nil {
+				log.Error("error storing idempotent response", zap.String("method", info.FullMethod), zap.String("key", key), zap.Error(err))
+			}
+		}
+		return resp, nil
+	}
+}
+
+// Fingerprint is an HMAC of the deterministic wire encoding of the request.
+// The request can still hold a raw card number, which a plain hash would not
+// protect: card numbers are few enough to be guessed back from their hash.
+func Fingerprint(secret []byte, req proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func keyFromContext(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
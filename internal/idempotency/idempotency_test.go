@@ -0,0 +1,279 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// memStore is an in-memory Store with the same semantics as the Postgres one.
+type memStore struct {
+	mu          sync.Mutex
+	records     map[string]*memRecord
+	completeErr error
+}
+
+type memRecord struct {
+	Record
+	owner      string
+	expiresAt  time.Time
+	leaseUntil time.Time
+}
+
+func newMemStore() *memStore {
+	return &memStore{records: map[string]*memRecord{}}
+}
+
+func (s *memStore) Reserve(_ context.Context, userID, method, key, owner, fingerprint string, expiresAt, leaseUntil time.Time) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := userID + method + key
+	if r, ok := s.records[id]; ok {
+		stale := r.Response == nil && !r.leaseUntil.After(time.Now()) && r.Fingerprint == fingerprint
+		if r.expiresAt.After(time.Now()) && !stale {
+			held := r.Record
+			return &held, nil
+		}
+	}
+	s.records[id] = &memRecord{Record: Record{Fingerprint: fingerprint}, owner: owner, expiresAt: expiresAt, leaseUntil: leaseUntil}
+	return nil, nil
+}
+
+func (s *memStore) Complete(_ context.Context, userID, method, key, owner string, response []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.completeErr != nil {
+		return s.completeErr
+	}
+	r, ok := s.records[userID+method+key]
+	if !ok || r.owner != owner {
+		return ErrNotOwner
+	}
+	r.Response = response
+	return nil
+}
+
+func (s *memStore) Release(_ context.Context, userID, method, key, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if r, ok := s.records[userID+method+key]; ok && r.owner == owner && r.Response == nil {
+		delete(s.records, userID+method+key)
+	}
+	return nil
+}
+
+const user = "user-1"
+
+var secret = []byte("test")
+
+var createOrder = &grpc.UnaryServerInfo{FullMethod: pb.OrderService_CreateOrder_FullMethodName}
+
+func withKey(key string) context.Context {
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: user})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, key))
+}
+
+func orderFor(kitchenID string) *pb.CreateOrderRequest {
+	return &pb.CreateOrderRequest{Order: &pb.Order{KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: "dish-1", Quantity: 1}}}}
+}
+
+// chdirTemp runs the test in an empty directory so the logger's app.log is
+// the test's own.
+func chdirTemp(t *testing.T) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	chdirTemp(t)
+	fingerprint := func(req proto.Message) string {
+		f, err := Fingerprint(secret, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return f
+	}
+	stored, err := proto.Marshal(&pb.CreateOrderResponse{Order: &pb.Order{Id: "order-0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		// seed is the record already holding the key, if any.
+		seed        *memRecord
+		req         *pb.CreateOrderRequest
+		handlerErr  error
+		completeErr error
+		// takenOver has a retry take the key over while the handler runs.
+		takenOver bool
+		wantCode  codes.Code
+		wantOrder string
+		wantCalls int
+		// wantHeld reports whether the key is still held after the request.
+		wantHeld bool
+	}{
+		{
+			name:      "first request runs and stores its response",
+			req:       orderFor("kitchen-1"),
+			wantOrder: "order-1", wantCalls: 1, wantHeld: true,
+		},
+		{
+			name:      "replay gets the stored response",
+			seed:      &memRecord{Record: Record{Fingerprint: fingerprint(orderFor("kitchen-1")), Response: stored}, expiresAt: time.Now().Add(time.Hour)},
+			req:       orderFor("kitchen-1"),
+			wantOrder: "order-0", wantCalls: 0, wantHeld: true,
+		},
+		{
+			name:     "different request with the same key",
+			seed:     &memRecord{Record: Record{Fingerprint: fingerprint(orderFor("kitchen-1")), Response: stored}, expiresAt: time.Now().Add(time.Hour)},
+			req:      orderFor("kitchen-2"),
+			wantCode: codes.AlreadyExists, wantCalls: 0, wantHeld: true,
+		},
+		{
+			name:     "request still in progress",
+			seed:     &memRecord{Record: Record{Fingerprint: fingerprint(orderFor("kitchen-1"))}, expiresAt: time.Now().Add(time.Hour), leaseUntil: time.Now().Add(time.Minute)},
+			req:      orderFor("kitchen-1"),
+			wantCode: codes.Aborted, wantCalls: 0, wantHeld: true,
+		},
+		{
+			name:      "request in progress past its lease is taken over",
+			seed:      &memRecord{Record: Record{Fingerprint: fingerprint(orderFor("kitchen-1"))}, expiresAt: time.Now().Add(time.Hour), leaseUntil: time.Now().Add(-time.Second)},
+			req:       orderFor("kitchen-1"),
+			wantOrder: "order-1", wantCalls: 1, wantHeld: true,
+		},
+		{
+			name:       "failed request releases the key",
+			req:        orderFor("kitchen-1"),
+			handlerErr: status.Error(codes.FailedPrecondition, "kitchen is closed"),
+			wantCode:   codes.FailedPrecondition, wantCalls: 1, wantHeld: false,
+		},
+		{
+			name:       "failed request keeps a key taken over by a retry",
+			req:        orderFor("kitchen-1"),
+			handlerErr: status.Error(codes.Unavailable, "database is down"),
+			takenOver:  true,
+			wantCode:   codes.Unavailable, wantCalls: 1, wantHeld: true,
+		},
+		{
+			name:      "response is not stored over a key taken over by a retry",
+			req:       orderFor("kitchen-1"),
+			takenOver: true,
+			wantOrder: "order-1", wantCalls: 1, wantHeld: true,
+		},
+		{
+			name:        "response is returned when it cannot be stored",
+			req:         orderFor("kitchen-1"),
+			completeErr: errors.New("connection reset"),
+			wantOrder:   "order-1", wantCalls: 1, wantHeld: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := newMemStore()
+			store.completeErr = tc.completeErr
+			if tc.seed != nil {
+				store.records[user+createOrder.FullMethod+"key"] = tc.seed
+			}
+
+			calls := 0
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				calls++
+				if tc.takenOver {
+					store.records[user+createOrder.FullMethod+"key"].owner = "retry"
+				}
+				if tc.handlerErr != nil {
+					return nil, tc.handlerErr
+				}
+				return &pb.CreateOrderResponse{Order: &pb.Order{Id: "order-1"}}, nil
+			}
+
+			resp, err := UnaryInterceptor(store, secret, time.Hour, Methods)(withKey("key"), tc.req, createOrder, handler)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("got %v, want %s", err, tc.wantCode)
+			}
+			if tc.wantCode == codes.OK {
+				if id := resp.(*pb.CreateOrderResponse).Order.Id; id != tc.wantOrder {
+					t.Errorf("got order %s, want %s", id, tc.wantOrder)
+				}
+			}
+			if calls != tc.wantCalls {
+				t.Errorf("handler ran %d times, want %d", calls, tc.wantCalls)
+			}
+			record, held := store.records[user+createOrder.FullMethod+"key"]
+			if held != tc.wantHeld {
+				t.Errorf("key held = %v, want %v", held, tc.wantHeld)
+			}
+			stored := tc.wantCode == codes.OK && tc.completeErr == nil && !tc.takenOver
+			if held && stored && record.Response == nil {
+				t.Error("response was not stored")
+			}
+			if held && tc.takenOver && (record.owner != "retry" || record.Response != nil) {
+				t.Errorf("the retry's reservation was changed: %+v", record)
+			}
+		})
+	}
+}
+
+func TestRequestsWithoutAKeyAreNotTracked(t *testing.T) {
+	store := newMemStore()
+	ctx := auth.NewContext(context.Background(), &auth.Principal{UserID: user})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &pb.CreateOrderResponse{}, nil
+	}
+
+	if _, err := UnaryInterceptor(store, secret, time.Hour, Methods)(ctx, orderFor("kitchen-1"), createOrder, handler); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.records) != 0 {
+		t.Fatalf("stored %d keys for a request without one", len(store.records))
+	}
+}
+
+func TestFingerprintIsKeyed(t *testing.T) {
+	req := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: "order-1", Amount: 12.5, PaymentMethod: "card", CardNumber: "4242424242424242"}}
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain := sha256.Sum256(body)
+
+	ours, err := Fingerprint(secret, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	theirs, err := Fingerprint([]byte("another secret"), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ours == hex.EncodeToString(plain[:]) {
+		t.Fatal("fingerprint is a plain hash of the request")
+	}
+	if ours == theirs {
+		t.Fatal("fingerprint does not depend on the secret")
+	}
+	if again, _ := Fingerprint(secret, req); again != ours {
+		t.Fatal("fingerprint is not stable")
+	}
+}
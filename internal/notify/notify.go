@@ -0,0 +1,115 @@
+// Package notify delivers account messages, such as password reset tokens, to
+// users.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	NotifierSMTP = "smtp"
+	NotifierLog  = "log"
+)
+
+// Notifier sends a user the raw token of a password reset. Only the token's
+// hash is stored, so this is the one place the token leaves the service.
+type Notifier interface {
+	PasswordReset(ctx context.Context, email, token string, expiresAt time.Time) error
+}
+
+// SMTPConfig is the mail server the SMTP notifier sends through. Username and
+// Password are optional.
+type SMTPConfig struct {
+	Addr     string
+	From     string
+	Username string
+	Password string
+}
+
+// New returns the notifier registered under name. There is no default: the
+// log notifier delivers nothing, so it has to be asked for by name.
+func New(name string, mail SMTPConfig) (Notifier, error) {
+	switch name {
+	case "":
+		return nil, errors.New("no notifier configured")
+	case NotifierLog:
+		return NewLog(), nil
+	case NotifierSMTP:
+		return NewSMTP(mail)
+	}
+	return nil, fmt.Errorf("unknown notifier %q", name)
+}
+
+// Log only records that a message was due, for development without a mail
+// server. The token is never written: anyone who can read the log could use
+// it to take over the account.
+type Log struct{}
+
+func NewLog() *Log {
+	return &Log{}
+}
+
+func (Log) PasswordReset(ctx context.Context, email, token string, expiresAt time.Time) error {
+	log, err := l.NewLogger()
+	if err != nil {
+		return err
+	}
+	log.Info("password reset requested, not sent by the log notifier", zap.String("email", email), zap.Time("expires_at", expiresAt))
+	return nil
+}
+
+// SMTP mails notifications through a mail server.
+type SMTP struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTP(mail SMTPConfig) (*SMTP, error) {
+	if mail.Addr == "" || mail.From == "" {
+		return nil, errors.New("the smtp notifier needs a server address and a from address")
+	}
+	host, _, err := net.SplitHostPort(mail.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp server address: %w", err)
+	}
+
+	s := &SMTP{addr: mail.Addr, from: mail.From}
+	if mail.Username != "" {
+		s.auth = smtp.PlainAuth("", mail.Username, mail.Password, host)
+	}
+	return s, nil
+}
+
+func (s *SMTP) PasswordReset(ctx context.Context, email, token string, expiresAt time.Time) error {
+	msg, err := resetMessage(s.from, email, token, expiresAt)
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(s.addr, s.auth, s.from, []string{email}, msg)
+}
+
+func resetMessage(from, to, token string, expiresAt time.Time) ([]byte, error) {
+	// The addresses go into headers, so they must not be able to add more.
+	if strings.ContainsAny(from+to, "\r\n") {
+		return nil, errors.New("mail address contains a line break")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	b.WriteString("Subject: Reset your LocalEats password\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&b, "Use this token to set a new password: %s\r\n\r\n", token)
+	fmt.Fprintf(&b, "It expires at %s. If you did not ask to reset your password, ignore this message.\r\n", expiresAt.UTC().Format(time.RFC1123))
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+const token = "0f3c9a7d5e8b1c2d4f6a8b0c1d3e5f7a9b1c3d5e7f9a0b2c4d6e8f0a1b3c5d7e"
+
+func TestNewRequiresANotifier(t *testing.T) {
+	for _, name := range []string{"", "pigeon"} {
+		if _, err := New(name, SMTPConfig{Addr: "localhost:25", From: "noreply@localeats.test"}); err == nil {
+			t.Errorf("New(%q) selected a notifier", name)
+		}
+	}
+	if _, err := New(NotifierSMTP, SMTPConfig{}); err == nil {
+		t.Error("New selected an smtp notifier without a server")
+	}
+	for _, name := range []string{NotifierLog, NotifierSMTP} {
+		if _, err := New(name, SMTPConfig{Addr: "localhost:25", From: "noreply@localeats.test"}); err != nil {
+			t.Errorf("New(%q): %v", name, err)
+		}
+	}
+}
+
+func TestLogNeverWritesTheToken(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	if err := NewLog().PasswordReset(context.Background(), "user@example.com", token, time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	out, err := os.ReadFile("app.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "user@example.com") {
+		t.Fatalf("the reset was not logged: %s", out)
+	}
+	if strings.Contains(string(out), token) {
+		t.Fatalf("the token was logged: %s", out)
+	}
+}
+
+func TestResetMessage(t *testing.T) {
+	expiresAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	msg, err := resetMessage("noreply@localeats.test", "user@example.com", token, expiresAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"To: user@example.com\r\n", token, "17 Oct 2026 12:00:00 UTC"} {
+		if !strings.Contains(string(msg), want) {
+			t.Errorf("message does not contain %q:\n%s", want, msg)
+		}
+	}
+
+	if _, err := resetMessage("noreply@localeats.test", "user@example.com\r\nBcc: attacker@example.com", token, expiresAt); err == nil {
+		t.Error("an address with a line break was accepted")
+	}
+}
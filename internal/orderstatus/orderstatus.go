@@ -0,0 +1,44 @@
+package orderstatus
+
+const (
+	Pending        = "pending"
+	Accepted       = "accepted"
+	Preparing      = "preparing"
+	Ready          = "ready"
+	OutForDelivery = "out_for_delivery"
+	Delivered      = "delivered"
+	Cancelled      = "cancelled"
+	Rejected       = "rejected"
+)
+
+// transitions is the order lifecycle. Orders move forward one step at a time
+// and can be cancelled until they leave the kitchen; only a pending order can
+// be rejected. Delivered, cancelled and rejected are final.
+var transitions = map[string][]string{
+	Pending:        {Accepted, Rejected, Cancelled},
+	Accepted:       {Preparing, Cancelled},
+	Preparing:      {Ready, Cancelled},
+	Ready:          {OutForDelivery, Cancelled},
+	OutForDelivery: {Delivered},
+	Delivered:      nil,
+	Cancelled:      nil,
+	Rejected:       nil,
+}
+
+func Valid(status string) bool {
+	_, ok := transitions[status]
+	return ok
+}
+
+func CanTransition(from, to string) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+func Final(status string) bool {
+	return Valid(status) && len(transitions[status]) == 0
+}
@@ -0,0 +1,50 @@
+package pagetoken
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRoundTrip(t *testing.T) {
+	codec := New([]byte("secret"))
+	scope := Scope("orders", "user-1", "", "pending")
+	cursor := Cursor{Value: 4.1, CreatedAt: time.Date(2024, 5, 1, 12, 30, 0, 123456000, time.UTC), ID: "0d2d8a4e-1b2c-4f6a-8e9d-3c4b5a6f7e80", Page: 3}
+
+	got, err := codec.Decode(scope, codec.Encode(scope, cursor))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Value != cursor.Value || !got.CreatedAt.Equal(cursor.CreatedAt) || got.ID != cursor.ID || got.Page != cursor.Page {
+		t.Fatalf("decoded %+v, want %+v", got, cursor)
+	}
+
+	if got, err := codec.Decode(scope, ""); got != nil || err != nil {
+		t.Fatalf("empty token decoded to %v, %v", got, err)
+	}
+}
+
+func TestRejectsForeignTokens(t *testing.T) {
+	codec := New([]byte("secret"))
+	scope := Scope("dishes", "kitchen-1")
+	token := codec.Encode(scope, Cursor{CreatedAt: time.Now(), ID: "dish-1", Page: 2})
+	body, signature, _ := strings.Cut(token, ".")
+
+	for name, tc := range map[string]struct {
+		codec *Codec
+		scope string
+		token string
+	}{
+		"other filters": {codec, Scope("dishes", "kitchen-2"), token},
+		"other listing": {codec, Scope("reviews", "kitchen-1"), token},
+		"other key":     {New([]byte("other")), scope, token},
+		"edited":        {codec, scope, body[:len(body)-2] + "xx." + signature},
+		"unsigned":      {codec, scope, body},
+		"garbage":       {codec, scope, "not a token"},
+	} {
+		if _, err := tc.codec.Decode(tc.scope, tc.token); !errors.Is(err, ErrInvalid) {
+			t.Errorf("%s: got %v, want ErrInvalid", name, err)
+		}
+	}
+}
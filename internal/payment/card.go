@@ -0,0 +1,83 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrInvalidCard      = apperr.New(apperr.Validation, "card number is invalid")
+	ErrCardsNotAccepted = apperr.New(apperr.Validation, "payment provider does not accept cards")
+)
+
+// Card is what the service keeps of a card: the provider's opaque token plus
+// the last four digits and brand for display. The full number never leaves
+// the provider.
+type Card struct {
+	Token string
+	Last4 string
+	Brand string
+}
+
+// TokenizeCards swaps the card number of incoming payments for a provider
+// token before any handler, log statement or store sees it. It runs after the
+// idempotency interceptor: a provider may issue a new token for every call, so
+// replays have to be fingerprinted on the card number the client sent.
+func TokenizeCards(provider Provider) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		r, ok := req.(*pb.CreatePaymentRequest)
+		if !ok || r.Payment == nil || r.Payment.CardNumber == "" {
+			return handler(ctx, req)
+		}
+
+		pan := normalizePAN(r.Payment.CardNumber)
+		r.Payment.CardNumber = ""
+
+		card, err := provider.Tokenize(ctx, pan)
+		if errors.Is(err, ErrInvalidCard) || errors.Is(err, ErrCardsNotAccepted) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, "unable to tokenize card")
+		}
+
+		r.Payment.CardToken = card.Token
+		r.Payment.CardLast4 = card.Last4
+		r.Payment.CardBrand = card.Brand
+		return handler(ctx, req)
+	}
+}
+
+// CardBrand infers the card network from the number's prefix.
+func CardBrand(pan string) string {
+	switch {
+	case strings.HasPrefix(pan, "4"):
+		return "visa"
+	case strings.HasPrefix(pan, "34"), strings.HasPrefix(pan, "37"):
+		return "amex"
+	case len(pan) >= 2 && pan[0] == '5' && pan[1] >= '1' && pan[1] <= '5',
+		len(pan) >= 4 && pan[:4] >= "2221" && pan[:4] <= "2720":
+		return "mastercard"
+	case strings.HasPrefix(pan, "6011"), strings.HasPrefix(pan, "65"):
+		return "discover"
+	}
+	return "unknown"
+}
+
+func last4(pan string) string {
+	if len(pan) < 4 {
+		return pan
+	}
+	return pan[len(pan)-4:]
+}
+
+func normalizePAN(number string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(number)
+}
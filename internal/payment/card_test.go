@@ -0,0 +1,134 @@
+package payment
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/config/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var createPayment = &grpc.UnaryServerInfo{FullMethod: pb.OrderService_CreatePayment_FullMethodName}
+
+// chdirTemp runs the test in an empty directory so the logger's app.log is
+// the test's own.
+func chdirTemp(t *testing.T) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func TestCardNumbersNeverReachTheLog(t *testing.T) {
+	chdirTemp(t)
+
+	log, err := logger.NewLogger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	interceptor := TokenizeCards(NewFake())
+	pans := []string{"4111111111111111", "4111 1111 1111 1111", "5555-5555-5555-4444", "378282246310005", "6011111111111117", "4000000000000000006"}
+
+	for _, pan := range pans {
+		req := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: "order-1", Amount: 12.5, PaymentMethod: "card", CardNumber: pan}}
+
+		_, err := interceptor(context.Background(), req, createPayment, func(ctx context.Context, req interface{}) (interface{}, error) {
+			p := req.(*pb.CreatePaymentRequest).Payment
+			log.Info("create payment", zap.Any("request", req))
+
+			if p.CardNumber != "" {
+				t.Errorf("handler saw card number %q", p.CardNumber)
+			}
+			if p.CardToken == "" || p.CardBrand == "" || p.CardLast4 != pan[len(pan)-4:] {
+				t.Errorf("payment = token %q, brand %q, last4 %q", p.CardToken, p.CardBrand, p.CardLast4)
+			}
+			return &pb.CreatePaymentResponse{Payment: p}, nil
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", pan, err)
+		}
+
+		// Code that logs a raw number by mistake is caught by the logger.
+		log.Error("careless", zap.String("card_number", pan), zap.Any("payment", &pb.Payment{CardNumber: pan}))
+	}
+	log.Sync()
+
+	out, err := os.ReadFile("app.log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "create payment") {
+		t.Fatalf("log is missing the handler entries:\n%s", out)
+	}
+
+	pan := regexp.MustCompile(`\d{13,19}`)
+	grouped := regexp.MustCompile(`\d(?:[ -]?\d){12,18}`)
+	for _, line := range strings.Split(string(out), "\n") {
+		if m := pan.FindString(line); m != "" {
+			t.Errorf("log contains PAN-like number %q: %s", m, line)
+		}
+		if m := grouped.FindString(line); m != "" {
+			t.Errorf("log contains grouped PAN-like number %q: %s", m, line)
+		}
+	}
+}
+
+func TestTokenizeCardsRejectsInvalidNumbers(t *testing.T) {
+	interceptor := TokenizeCards(NewFake())
+	req := &pb.CreatePaymentRequest{Payment: &pb.Payment{CardNumber: "4111111111111112"}}
+
+	_, err := interceptor(context.Background(), req, createPayment, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler called for an invalid card")
+		return nil, nil
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+	if req.Payment.CardNumber != "" {
+		t.Fatalf("card number kept on rejected request")
+	}
+}
+
+func TestFakeTokensAreStable(t *testing.T) {
+	f := NewFake()
+	ctx := context.Background()
+
+	first, err := f.Tokenize(ctx, "4111111111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := f.Tokenize(ctx, "4111111111111111")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Fatalf("tokens differ: %+v, %+v", first, second)
+	}
+	if first.Brand != "visa" || first.Last4 != "1111" {
+		t.Fatalf("card = %+v", first)
+	}
+
+	declined, err := f.Tokenize(ctx, DeclinedCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Authorize(ctx, AuthorizeRequest{OrderID: "order-1", Amount: 10, Card: declined}); err != ErrDeclined {
+		t.Fatalf("Authorize declined card: err = %v", err)
+	}
+	if _, err := f.Authorize(ctx, AuthorizeRequest{OrderID: "order-1", Amount: 10, Card: first}); err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package payment
+
+import "context"
+
+// CashOnDelivery records payments that are settled in cash by the courier.
+// Nothing is charged up front; the authorization is a promise to pay that the
+// kitchen captures when the order is delivered, and refunds are handed back in
+// cash.
+type CashOnDelivery struct{}
+
+func NewCashOnDelivery() *CashOnDelivery {
+	return &CashOnDelivery{}
+}
+
+func (c *CashOnDelivery) Name() string {
+	return ProviderCashOnDelivery
+}
+
+func (c *CashOnDelivery) Tokenize(ctx context.Context, pan string) (Card, error) {
+	return Card{}, ErrCardsNotAccepted
+}
+
+func (c *CashOnDelivery) Authorize(ctx context.Context, req AuthorizeRequest) (string, error) {
+	if req.Amount <= 0 {
+		return "", ErrInvalidAmount
+	}
+	return "cod_" + req.OrderID, nil
+}
+
+func (c *CashOnDelivery) Capture(ctx context.Context, transactionID string, amount float64) error {
+	if amount <= 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
+
+func (c *CashOnDelivery) Refund(ctx context.Context, transactionID string, amount float64) (string, error) {
+	if amount <= 0 {
+		return "", ErrInvalidAmount
+	}
+	return transactionID + "_refund", nil
+}
+
+func (c *CashOnDelivery) Void(ctx context.Context, transactionID string) error {
+	return nil
+}
@@ -0,0 +1,158 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// DeclinedCard is always declined by the fake provider.
+const DeclinedCard = "4000000000000002"
+
+// Fake is a deterministic in-memory processor for development and tests. It
+// approves any Luhn-valid card except DeclinedCard, numbers its transactions
+// sequentially and always returns the same token for the same card.
+type Fake struct {
+	mu           sync.Mutex
+	seq          int
+	tokens       map[string]string
+	cards        map[string]string
+	transactions map[string]*fakeTransaction
+}
+
+type fakeTransaction struct {
+	authorized int64
+	captured   int64
+	refunded   int64
+	voided     bool
+}
+
+func NewFake() *Fake {
+	return &Fake{
+		tokens:       map[string]string{},
+		cards:        map[string]string{},
+		transactions: map[string]*fakeTransaction{},
+	}
+}
+
+func (f *Fake) Name() string {
+	return ProviderFake
+}
+
+func (f *Fake) Tokenize(ctx context.Context, pan string) (Card, error) {
+	if !luhn(pan) {
+		return Card{}, ErrInvalidCard
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	token, ok := f.tokens[pan]
+	if !ok {
+		token = f.next("tok")
+		f.tokens[pan] = token
+		f.cards[token] = pan
+	}
+	return Card{Token: token, Last4: last4(pan), Brand: CardBrand(pan)}, nil
+}
+
+func (f *Fake) Authorize(ctx context.Context, req AuthorizeRequest) (string, error) {
+	if req.Amount <= 0 {
+		return "", ErrInvalidAmount
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pan, ok := f.cards[req.Card.Token]
+	if !ok {
+		return "", ErrInvalidCard
+	}
+	if pan == DeclinedCard {
+		return "", ErrDeclined
+	}
+
+	id := f.next("auth")
+	f.transactions[id] = &fakeTransaction{authorized: cents(req.Amount)}
+	return id, nil
+}
+
+func (f *Fake) Capture(ctx context.Context, transactionID string, amount float64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tx, ok := f.transactions[transactionID]
+	if !ok || tx.voided {
+		return ErrUnknownTransaction
+	}
+	if amount <= 0 {
+		return ErrInvalidAmount
+	}
+	if tx.captured+cents(amount) > tx.authorized {
+		return ErrAmountExceeded
+	}
+	tx.captured += cents(amount)
+	return nil
+}
+
+func (f *Fake) Refund(ctx context.Context, transactionID string, amount float64) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tx, ok := f.transactions[transactionID]
+	if !ok {
+		return "", ErrUnknownTransaction
+	}
+	if amount <= 0 {
+		return "", ErrInvalidAmount
+	}
+	if tx.refunded+cents(amount) > tx.captured {
+		return "", ErrAmountExceeded
+	}
+	tx.refunded += cents(amount)
+	return f.next("refund"), nil
+}
+
+func (f *Fake) Void(ctx context.Context, transactionID string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	tx, ok := f.transactions[transactionID]
+	if !ok || tx.captured > 0 {
+		return ErrUnknownTransaction
+	}
+	tx.voided = true
+	return nil
+}
+
+func (f *Fake) next(kind string) string {
+	f.seq++
+	return fmt.Sprintf("fake_%s_%06d", kind, f.seq)
+}
+
+func luhn(number string) bool {
+	if len(number) < 12 || len(number) > 19 {
+		return false
+	}
+	sum := 0
+	for i := range number {
+		c := number[len(number)-1-i]
+		if c < '0' || c > '9' {
+			return false
+		}
+		d := int(c - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func cents(v float64) int64 {
+	return int64(math.Round(v * 100))
+}
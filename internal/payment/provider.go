@@ -0,0 +1,106 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+)
+
+const (
+	ProviderFake           = "fake"
+	ProviderCashOnDelivery = "cod"
+)
+
+const (
+	MethodCard = "card"
+	MethodCash = "cash"
+)
+
+const (
+	StatusPending           = "pending"
+	StatusAuthorized        = "authorized"
+	StatusCaptured          = "captured"
+	StatusFailed            = "failed"
+	StatusRefunded          = "refunded"
+	StatusPartiallyRefunded = "partially_refunded"
+	StatusVoided            = "voided"
+)
+
+var (
+	ErrDeclined           = apperr.New(apperr.Precondition, "payment declined")
+	ErrInvalidAmount      = apperr.New(apperr.Validation, "payment amount must be positive")
+	ErrUnknownTransaction = errors.New("unknown payment transaction")
+	ErrAmountExceeded     = apperr.New(apperr.Precondition, "amount exceeds what the transaction allows")
+	ErrMethodNotServed    = apperr.Invalid("payment.payment_method", "payment method is not accepted by the configured payment provider")
+)
+
+type AuthorizeRequest struct {
+	OrderID string
+	Amount  float64
+	Method  string
+	Card    Card
+}
+
+// Provider is a payment processor. Tokenize vaults a card number with the
+// provider. Authorize reserves the amount and returns the provider's
+// transaction id, which the other methods operate on.
+type Provider interface {
+	Name() string
+	Tokenize(ctx context.Context, pan string) (Card, error)
+	Authorize(ctx context.Context, req AuthorizeRequest) (string, error)
+	Capture(ctx context.Context, transactionID string, amount float64) error
+	Refund(ctx context.Context, transactionID string, amount float64) (string, error)
+	Void(ctx context.Context, transactionID string) error
+}
+
+// Serves reports whether p takes payments made with method. Cash on delivery
+// only takes cash; every other provider is a card processor.
+func Serves(p Provider, method string) bool {
+	if p.Name() == ProviderCashOnDelivery {
+		return method == MethodCash
+	}
+	return method == MethodCard
+}
+
+// New returns the provider registered under name. There is no default: the
+// fake provider approves any valid card, so it has to be asked for by name.
+func New(name string) (Provider, error) {
+	switch name {
+	case "":
+		return nil, errors.New("no payment provider configured")
+	case ProviderFake:
+		return NewFake(), nil
+	case ProviderCashOnDelivery:
+		return NewCashOnDelivery(), nil
+	}
+	return nil, fmt.Errorf("unknown payment provider %q", name)
+}
+
+// progress orders the statuses a payment moves through.
+var progress = map[string]int{
+	StatusPending:           0,
+	StatusAuthorized:        1,
+	StatusCaptured:          2,
+	StatusPartiallyRefunded: 3,
+	StatusRefunded:          4,
+}
+
+// Advances reports whether a payment may move from one status to another.
+// Payments only move forward, so a late or repeated processor notification is
+// never applied over a newer state. Failed and voided payments are final.
+func Advances(from, to string) bool {
+	switch to {
+	case StatusFailed:
+		return from == StatusPending || from == StatusAuthorized
+	case StatusVoided:
+		return from == StatusAuthorized
+	}
+	f, ok := progress[from]
+	if !ok {
+		return false
+	}
+	t, ok := progress[to]
+	return ok && t > f
+}
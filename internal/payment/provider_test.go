@@ -0,0 +1,36 @@
+package payment
+
+import "testing"
+
+func TestNewRequiresAProvider(t *testing.T) {
+	for _, name := range []string{"", "stripe"} {
+		if _, err := New(name); err == nil {
+			t.Errorf("New(%q) selected a provider", name)
+		}
+	}
+	for _, name := range []string{ProviderFake, ProviderCashOnDelivery} {
+		p, err := New(name)
+		if err != nil || p.Name() != name {
+			t.Errorf("New(%q) = %v, %v", name, p, err)
+		}
+	}
+}
+
+func TestServes(t *testing.T) {
+	tests := []struct {
+		provider Provider
+		method   string
+		want     bool
+	}{
+		{NewFake(), MethodCard, true},
+		{NewFake(), MethodCash, false},
+		{NewCashOnDelivery(), MethodCash, true},
+		{NewCashOnDelivery(), MethodCard, false},
+		{NewFake(), "crypto", false},
+	}
+	for _, tt := range tests {
+		if got := Serves(tt.provider, tt.method); got != tt.want {
+			t.Errorf("Serves(%s, %q) = %v, want %v", tt.provider.Name(), tt.method, got, tt.want)
+		}
+	}
+}
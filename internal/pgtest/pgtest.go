@@ -0,0 +1,188 @@
+// Package pgtest gives tests their own Postgres schema. Packages that use it
+// call Main from TestMain, which either connects to the server named by
+// PG_TEST_DSN or creates a throwaway cluster with initdb in a temporary
+// directory, and then call Open in each test.
+//
+// Without a server the tests are skipped, unless CI or REQUIRE_POSTGRES is
+// set, in which case they fail. Postgres refuses to run as root, so when the
+// tests do the throwaway cluster is run as POSTGRES_USER instead. Set
+// POSTGRES_BIN to the directory holding initdb and pg_ctl if they are not on
+// the PATH.
+package pgtest
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+var (
+	dsn        string
+	skipReason string
+)
+
+// Main runs the package's tests in a temporary working directory, where the
+// logger writes its app.log, with a Postgres server to Open schemas on.
+func Main(m *testing.M) int {
+	workDir, err := os.MkdirTemp("", "pgtest")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	defer os.RemoveAll(workDir)
+	if err := os.Chdir(workDir); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	if dsn = os.Getenv("PG_TEST_DSN"); dsn != "" {
+		if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+			if dsn, err = pq.ParseURL(dsn); err != nil {
+				fmt.Fprintln(os.Stderr, "PG_TEST_DSN:", err)
+				return 1
+			}
+		}
+		return m.Run()
+	}
+
+	bin, err := postgresBin()
+	if err == nil {
+		var stop func()
+		if stop, err = startPostgres(bin, workDir); err == nil {
+			defer stop()
+			return m.Run()
+		}
+	}
+	if required() {
+		fmt.Fprintln(os.Stderr, "postgres is required:", err)
+		return 1
+	}
+	skipReason = err.Error()
+	return m.Run()
+}
+
+// required reports whether missing Postgres fails the tests rather than
+// skipping them.
+func required() bool {
+	return os.Getenv("CI") != "" || os.Getenv("REQUIRE_POSTGRES") != ""
+}
+
+// Open returns a connection to a new, empty schema that is dropped when the
+// test ends. It skips the test when there is no server.
+func Open(t testing.TB) *sql.DB {
+	t.Helper()
+	if dsn == "" {
+		t.Skip(skipReason)
+	}
+
+	admin, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { admin.Close() })
+
+	schema := "test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := admin.Exec(`create schema ` + schema); err != nil {
+		t.Fatalf("creating schema: %v", err)
+	}
+	t.Cleanup(func() { admin.Exec(`drop schema ` + schema + ` cascade`) })
+
+	db, err := sql.Open("postgres", dsn+" search_path="+schema)
+	if err == nil {
+		err = db.Ping()
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// postgresBin finds the directory holding initdb and pg_ctl.
+func postgresBin() (string, error) {
+	if dir := os.Getenv("POSTGRES_BIN"); dir != "" {
+		return dir, nil
+	}
+	if path, err := exec.LookPath("initdb"); err == nil {
+		return filepath.Dir(path), nil
+	}
+	// Debian and Ubuntu keep the server binaries out of the PATH.
+	matches, _ := filepath.Glob("/usr/lib/postgresql/*/bin/initdb")
+	if len(matches) > 0 {
+		sort.Strings(matches)
+		return filepath.Dir(matches[len(matches)-1]), nil
+	}
+	return "", errors.New("postgres binaries not found, set POSTGRES_BIN or PG_TEST_DSN to run these tests")
+}
+
+// startPostgres initialises a cluster under dir and serves it on a unix socket
+// in the same directory, so it never clashes with a running server.
+func startPostgres(bin, dir string) (func(), error) {
+	owner, err := clusterOwner(dir)
+	if err != nil {
+		return nil, err
+	}
+	command := func(name string, args ...string) *exec.Cmd {
+		cmd := exec.Command(filepath.Join(bin, name), args...)
+		cmd.SysProcAttr = owner
+		return cmd
+	}
+
+	data := filepath.Join(dir, "data")
+	initdb := command("initdb", "-D", data, "-U", "postgres", "-A", "trust", "-E", "UTF8", "--no-locale", "--no-sync")
+	if out, err := initdb.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("initdb: %w\n%s", err, out)
+	}
+
+	options := fmt.Sprintf("-k %s -c listen_addresses='' -c fsync=off -c synchronous_commit=off -c full_page_writes=off -c TimeZone=UTC", dir)
+	start := command("pg_ctl", "-D", data, "-l", filepath.Join(dir, "postgres.log"), "-o", options, "-w", "start")
+	if out, err := start.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("pg_ctl start: %w\n%s", err, out)
+	}
+	stop := func() {
+		command("pg_ctl", "-D", data, "-m", "immediate", "stop").Run()
+	}
+
+	dsn = fmt.Sprintf("host=%s user=postgres dbname=postgres sslmode=disable", dir)
+	return stop, nil
+}
+
+// clusterOwner returns the credentials to run the server binaries with: none
+// for a regular user, and POSTGRES_USER's, who is given dir, for root.
+func clusterOwner(dir string) (*syscall.SysProcAttr, error) {
+	if os.Geteuid() != 0 {
+		return nil, nil
+	}
+	name := os.Getenv("POSTGRES_USER")
+	if name == "" {
+		return nil, errors.New("postgres cannot run as root, set POSTGRES_USER to a non-root user or PG_TEST_DSN to run these tests")
+	}
+	u, err := user.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chown(dir, int(uid), int(gid)); err != nil {
+		return nil, err
+	}
+	return &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}}, nil
+}
@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Ownership resolves which user owns a kitchen and which kitchen a dish belongs to.
+type Ownership interface {
+	KitchenOwner(ctx context.Context, kitchenID string) (string, error)
+	DishKitchen(ctx context.Context, dishID string) (string, error)
+}
+
+// KitchenPolicy allows kitchen-scoped mutations only to the kitchen's owner or an admin.
+type KitchenPolicy struct {
+	Ownership Ownership
+}
+
+func NewKitchenPolicy(ownership Ownership) *KitchenPolicy {
+	return &KitchenPolicy{Ownership: ownership}
+}
+
+// Authorize checks that caller may perform the kitchen-scoped request. Requests
+// that are not kitchen-scoped are allowed.
+func (p *KitchenPolicy) Authorize(ctx context.Context, caller *auth.Principal, req interface{}) error {
+	var kitchenID, dishID string
+
+	switch r := req.(type) {
+	case *pb.CreateDishRequest:
+		if r.Dish != nil {
+			kitchenID = r.Dish.KitchenId
+		}
+	case *pb.UpdateDishRequest:
+		dishID = r.DishId
+	case *pb.DeleteDishRequest:
+		dishID = r.DishId
+	case *pb.UpdateDishNutritionInfoRequest:
+		dishID = r.DishId
+	case *pb.UpdateWorkingHoursRequest:
+		kitchenID = r.KitchenId
+	case *pb.GetKitchenStatisticsRequest:
+		kitchenID = r.KitchenId
+	default:
+		return nil
+	}
+
+	if caller == nil {
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if caller.IsAdmin() {
+		return nil
+	}
+
+	if dishID != "" {
+		var err error
+		kitchenID, err = p.Ownership.DishKitchen(ctx, dishID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return status.Error(codes.NotFound, "dish not found")
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return p.AuthorizeKitchen(ctx, caller, kitchenID)
+}
+
+// AuthorizeKitchen checks that caller owns kitchenID or is an admin.
+func (p *KitchenPolicy) AuthorizeKitchen(ctx context.Context, caller *auth.Principal, kitchenID string) error {
+	if caller == nil {
+		return status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	if caller.IsAdmin() {
+		return nil
+	}
+	if kitchenID == "" {
+		return status.Error(codes.InvalidArgument, "kitchen_id is required")
+	}
+
+	ownerID, err := p.Ownership.KitchenOwner(ctx, kitchenID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "kitchen not found")
+	}
+	if err != nil {
+		return err
+	}
+	if ownerID != caller.UserID {
+		return status.Error(codes.PermissionDenied, "caller does not own this kitchen")
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package policy
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeOwnership struct {
+	kitchenOwners map[string]string
+	dishKitchens  map[string]string
+}
+
+func (f fakeOwnership) KitchenOwner(_ context.Context, kitchenID string) (string, error) {
+	owner, ok := f.kitchenOwners[kitchenID]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	return owner, nil
+}
+
+func (f fakeOwnership) DishKitchen(_ context.Context, dishID string) (string, error) {
+	kitchen, ok := f.dishKitchens[dishID]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	return kitchen, nil
+}
+
+func TestKitchenPolicyAuthorize(t *testing.T) {
+	p := NewKitchenPolicy(fakeOwnership{
+		kitchenOwners: map[string]string{"kitchen-1": "owner", "kitchen-2": "other-chef"},
+		dishKitchens:  map[string]string{"dish-1": "kitchen-1", "dish-2": "kitchen-2"},
+	})
+
+	owner := &auth.Principal{UserID: "owner", UserType: "chef"}
+	stranger := &auth.Principal{UserID: "stranger", UserType: "chef"}
+	customer := &auth.Principal{UserID: "customer", UserType: "customer"}
+	admin := &auth.Principal{UserID: "admin", UserType: auth.UserTypeAdmin}
+
+	// Every kitchen-scoped RPC, addressed at kitchen-1 or a dish of kitchen-1.
+	requests := map[string]func(kitchenID, dishID string) interface{}{
+		"CreateDish": func(k, _ string) interface{} {
+			return &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: k}}
+		},
+		"UpdateDish": func(_, d string) interface{} {
+			return &pb.UpdateDishRequest{DishId: d, Dish: &pb.Dish{Id: d}}
+		},
+		"DeleteDish": func(_, d string) interface{} {
+			return &pb.DeleteDishRequest{DishId: d}
+		},
+		"UpdateDishNutritionInfo": func(_, d string) interface{} {
+			return &pb.UpdateDishNutritionInfoRequest{DishId: d}
+		},
+		"UpdateWorkingHours": func(k, _ string) interface{} {
+			return &pb.UpdateWorkingHoursRequest{KitchenId: k}
+		},
+		"GetKitchenStatistics": func(k, _ string) interface{} {
+			return &pb.GetKitchenStatisticsRequest{KitchenId: k}
+		},
+	}
+
+	tests := []struct {
+		name      string
+		caller    *auth.Principal
+		kitchenID string
+		dishID    string
+		want      codes.Code
+	}{
+		{"owner", owner, "kitchen-1", "dish-1", codes.OK},
+		{"admin", admin, "kitchen-1", "dish-1", codes.OK},
+		{"admin on missing kitchen", admin, "missing", "missing", codes.OK},
+		{"other chef", stranger, "kitchen-1", "dish-1", codes.PermissionDenied},
+		{"customer", customer, "kitchen-1", "dish-1", codes.PermissionDenied},
+		{"owner of another kitchen", owner, "kitchen-2", "dish-2", codes.PermissionDenied},
+		{"missing kitchen or dish", owner, "missing", "missing", codes.NotFound},
+		{"unauthenticated", nil, "kitchen-1", "dish-1", codes.Unauthenticated},
+	}
+
+	for rpc, newRequest := range requests {
+		for _, tt := range tests {
+			t.Run(rpc+"/"+tt.name, func(t *testing.T) {
+				err := p.Authorize(context.Background(), tt.caller, newRequest(tt.kitchenID, tt.dishID))
+				if got := status.Code(err); got != tt.want {
+					t.Errorf("Authorize() code = %v, want %v (err: %v)", got, tt.want, err)
+				}
+			})
+		}
+	}
+}
+
+func TestKitchenPolicyIgnoresOtherRequests(t *testing.T) {
+	p := NewKitchenPolicy(fakeOwnership{})
+
+	for _, req := range []interface{}{
+		&pb.ListDishesRequest{KitchenId: "kitchen-1"},
+		&pb.CreateOrderRequest{},
+		&pb.ListReviewsRequest{},
+	} {
+		if err := p.Authorize(context.Background(), nil, req); err != nil {
+			t.Errorf("Authorize(%T) = %v, want nil", req, err)
+		}
+	}
+}
+
+func TestKitchenPolicyRequiresKitchenID(t *testing.T) {
+	p := NewKitchenPolicy(fakeOwnership{})
+	caller := &auth.Principal{UserID: "owner", UserType: "chef"}
+
+	err := p.Authorize(context.Background(), caller, &pb.CreateDishRequest{})
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Errorf("Authorize() code = %v, want InvalidArgument", got)
+	}
+}
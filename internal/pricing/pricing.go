@@ -0,0 +1,97 @@
+package pricing
+
+import (
+	"math"
+
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+)
+
+var ErrDiscountMinimum = apperr.New(apperr.Precondition, "order subtotal is below the discount minimum")
+
+// Fees are the service-wide defaults for kitchens that have not set their own.
+type Fees struct {
+	DeliveryFee float64
+	TaxRate     float64
+}
+
+type Discount struct {
+	PercentOff  float64
+	AmountOff   float64
+	MinSubtotal float64
+}
+
+type Line struct {
+	Price    float64
+	Quantity int32
+}
+
+type Breakdown struct {
+	Subtotal    float64
+	DeliveryFee float64
+	Tax         float64
+	Discount    float64
+	Total       float64
+}
+
+// Quote prices an order. The discount is taken off the subtotal and never
+// exceeds it, tax is charged on the discounted subtotal, and the delivery fee
+// is added untaxed. Every amount is rounded to cents.
+func Quote(lines []Line, deliveryFee, taxRate float64, discount *Discount) (Breakdown, error) {
+	var subtotal int64
+	for _, line := range lines {
+		subtotal += cents(line.Price) * int64(line.Quantity)
+	}
+
+	var off int64
+	if discount != nil {
+		if subtotal < cents(discount.MinSubtotal) {
+			return Breakdown{}, ErrDiscountMinimum
+		}
+		off = int64(math.Round(float64(subtotal)*discount.PercentOff/100)) + cents(discount.AmountOff)
+		if off > subtotal {
+			off = subtotal
+		}
+	}
+
+	fee := cents(deliveryFee)
+	tax := int64(math.Round(float64(subtotal-off) * taxRate))
+
+	return Breakdown{
+		Subtotal:    amount(subtotal),
+		DeliveryFee: amount(fee),
+		Tax:         amount(tax),
+		Discount:    amount(off),
+		Total:       amount(subtotal - off + tax + fee),
+	}, nil
+}
+
+// ItemRefund is what the customer paid for items whose undiscounted price is
+// gross: gross less its share of the order's discount, plus the tax charged on
+// the rest, both pro-rated over the subtotal as Quote spreads them. The
+// delivery fee is not part of it.
+func ItemRefund(order Breakdown, gross float64) float64 {
+	subtotal := cents(order.Subtotal)
+	if subtotal == 0 {
+		return 0
+	}
+	paid := subtotal - cents(order.Discount) + cents(order.Tax)
+	return amount(int64(math.Round(float64(cents(gross)) * float64(paid) / float64(subtotal))))
+}
+
+// Equal reports whether two amounts are the same to the cent.
+func Equal(a, b float64) bool {
+	return cents(a) == cents(b)
+}
+
+// Round rounds an amount to cents.
+func Round(v float64) float64 {
+	return amount(cents(v))
+}
+
+func cents(v float64) int64 {
+	return int64(math.Round(v * 100))
+}
+
+func amount(c int64) float64 {
+	return float64(c) / 100
+}
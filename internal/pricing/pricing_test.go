@@ -0,0 +1,118 @@
+package pricing
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	soups := []Line{{Price: 10, Quantity: 2}}
+
+	tests := []struct {
+		name        string
+		lines       []Line
+		deliveryFee float64
+		taxRate     float64
+		discount    *Discount
+		want        Breakdown
+		wantErr     error
+	}{
+		{
+			name:        "no discount",
+			lines:       []Line{{Price: 10, Quantity: 2}, {Price: 3.35, Quantity: 1}},
+			deliveryFee: 2, taxRate: 0.1,
+			// 10% of 23.35 is 2.335, rounded half away from zero.
+			want: Breakdown{Subtotal: 23.35, DeliveryFee: 2, Tax: 2.34, Total: 27.69},
+		},
+		{
+			name:  "tax is charged after the discount",
+			lines: soups, deliveryFee: 2, taxRate: 0.1,
+			discount: &Discount{PercentOff: 10},
+			want:     Breakdown{Subtotal: 20, DeliveryFee: 2, Tax: 1.8, Discount: 2, Total: 21.8},
+		},
+		{
+			name:  "percent and amount off add up",
+			lines: soups, deliveryFee: 2, taxRate: 0.1,
+			discount: &Discount{PercentOff: 10, AmountOff: 5},
+			want:     Breakdown{Subtotal: 20, DeliveryFee: 2, Tax: 1.3, Discount: 7, Total: 16.3},
+		},
+		{
+			name:  "discount is capped at the subtotal",
+			lines: soups, deliveryFee: 2, taxRate: 0.1,
+			discount: &Discount{AmountOff: 50},
+			// The delivery fee is still charged.
+			want: Breakdown{Subtotal: 20, DeliveryFee: 2, Discount: 20, Total: 2},
+		},
+		{
+			name:  "subtotal at the minimum",
+			lines: soups, deliveryFee: 2, taxRate: 0.1,
+			discount: &Discount{AmountOff: 5, MinSubtotal: 20},
+			want:     Breakdown{Subtotal: 20, DeliveryFee: 2, Tax: 1.5, Discount: 5, Total: 18.5},
+		},
+		{
+			name:  "subtotal below the minimum",
+			lines: soups, deliveryFee: 2, taxRate: 0.1,
+			discount: &Discount{AmountOff: 5, MinSubtotal: 20.01},
+			wantErr:  ErrDiscountMinimum,
+		},
+		{
+			name:  "every amount is rounded to cents",
+			lines: []Line{{Price: 0.1, Quantity: 3}}, taxRate: 0.0825,
+			// 15% of 30 cents is 4.5 cents and the tax on 25 cents 2.0625.
+			discount: &Discount{PercentOff: 15},
+			want:     Breakdown{Subtotal: 0.3, Tax: 0.02, Discount: 0.05, Total: 0.27},
+		},
+		{
+			name:        "empty order",
+			deliveryFee: 2, taxRate: 0.1,
+			want: Breakdown{DeliveryFee: 2, Total: 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Quote(tc.lines, tc.deliveryFee, tc.taxRate, tc.discount)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in, want float64
+	}{
+		{10, 10},
+		{10.454, 10.45},
+		{10.456, 10.46},
+		{0.125, 0.13},
+		{0.1 + 0.2, 0.3},
+	}
+	for _, tc := range tests {
+		if got := Round(tc.in); got != tc.want {
+			t.Errorf("Round(%v) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b float64
+		want bool
+	}{
+		{0.1 + 0.2, 0.3, true},
+		{21.8, 21.799, true},
+		{10, 10.004, true},
+		{10, 10.01, false},
+		{10, -10, false},
+	}
+	for _, tc := range tests {
+		if got := Equal(tc.a, tc.b); got != tc.want {
+			t.Errorf("Equal(%v, %v) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+)
+
+// IdempotencyRepository is the Postgres backed idempotency.Store.
+type IdempotencyRepository struct {
+	DB *sql.DB
+}
+
+func NewIdempotencyRepository(db *sql.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{DB: db}
+}
+
+// Reserve inserts the key, taking over an expired one or an in-progress one
+// whose lease has run out, or returns the record that currently holds it.
+func (i *IdempotencyRepository) Reserve(ctx context.Context, userID, method, key, owner, fingerprint string, expiresAt, leaseUntil time.Time) (*idempotency.Record, error) {
+	query := `insert into idempotency_keys (user_id, method, key, fingerprint, expires_at, locked_until, owner)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		on conflict (user_id, method, key) do update set
+			owner = excluded.owner,
+			fingerprint = excluded.fingerprint,
+			response = null,
+			created_at = now(),
+			expires_at = excluded.expires_at,
+			locked_until = excluded.locked_until
+		where idempotency_keys.expires_at <= now()
+			or (idempotency_keys.response is null and idempotency_keys.locked_until <= now()
+				and idempotency_keys.fingerprint = excluded.fingerprint)`
+
+	result, err := i.DB.ExecContext(ctx, query, userID, method, key, fingerprint, expiresAt, leaseUntil, owner)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if reserved == 1 {
+		return nil, nil
+	}
+
+	record := &idempotency.Record{}
+	err = i.DB.QueryRowContext(ctx, `select fingerprint, response from idempotency_keys where user_id = $1 and method = $2 and key = $3`, userID, method, key).
+		Scan(&record.Fingerprint, &record.Response)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Released between the insert and the select; try again.
+		return i.Reserve(ctx, userID, method, key, owner, fingerprint, expiresAt, leaseUntil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (i *IdempotencyRepository) Complete(ctx context.Context, userID, method, key, owner string, response []byte) error {
+	query := `update idempotency_keys set response = $1 where user_id = $2 and method = $3 and key = $4 and owner = $5`
+
+	result, err := i.DB.ExecContext(ctx, query, response, userID, method, key, owner)
+	if err != nil {
+		return err
+	}
+	completed, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if completed == 0 {
+		return idempotency.ErrNotOwner
+	}
+	return nil
+}
+
+func (i *IdempotencyRepository) Release(ctx context.Context, userID, method, key, owner string) error {
+	query := `delete from idempotency_keys where user_id = $1 and method = $2 and key = $3 and owner = $4 and response is null`
+
+	_, err := i.DB.ExecContext(ctx, query, userID, method, key, owner)
+	return err
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"Github.com/LocalEats/Order-Service/internal/idempotency"
+	"github.com/google/uuid"
+)
+
+func TestIdempotencyKeys(t *testing.T) {
+	db := newTestDB(t)
+	keys := NewIdempotencyRepository(db)
+	ctx := context.Background()
+	user := seedUser(t, db, "customer")
+	const method = "/order.OrderService/CreateOrder"
+	later := time.Now().Add(time.Hour)
+	owner := uuid.NewString()
+
+	// Fingerprints are HMAC-SHA256 hex digests stored in a char(64) column.
+	fp := func(c string) string { return strings.Repeat(c, 64) }
+
+	record, err := keys.Reserve(ctx, user, method, "key-1", owner, fp("a"), later, later)
+	if err != nil || record != nil {
+		t.Fatalf("first Reserve = %v, %v", record, err)
+	}
+	record, err = keys.Reserve(ctx, user, method, "key-1", owner, fp("b"), later, later)
+	if err != nil || record == nil || record.Fingerprint != fp("a") || record.Response != nil {
+		t.Fatalf("Reserve while in progress = %v, %v", record, err)
+	}
+
+	if err := keys.Complete(ctx, user, method, "key-1", owner, []byte("response")); err != nil {
+		t.Fatal(err)
+	}
+	// A completed key is kept by Release.
+	if err := keys.Release(ctx, user, method, "key-1", owner); err != nil {
+		t.Fatal(err)
+	}
+	record, err = keys.Reserve(ctx, user, method, "key-1", owner, fp("a"), later, later)
+	if err != nil || record == nil || string(record.Response) != "response" {
+		t.Fatalf("Reserve after completing = %v, %v", record, err)
+	}
+
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("a"), later, later); err != nil || record != nil {
+		t.Fatalf("Reserve of a second key = %v, %v", record, err)
+	}
+	if err := keys.Release(ctx, user, method, "key-2", owner); err != nil {
+		t.Fatal(err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("c"), later, later); err != nil || record != nil {
+		t.Fatalf("Reserve after releasing = %v, %v", record, err)
+	}
+
+	// key-2 is in progress; once its lease runs out a retry of the same
+	// request takes it over, but a different request still may not.
+	if _, err := db.Exec(`update idempotency_keys set locked_until = now() - interval '1 second' where key = 'key-2'`); err != nil {
+		t.Fatal(err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("d"), later, later); err != nil || record == nil || record.Fingerprint != fp("c") {
+		t.Fatalf("Reserve of a stale key with another fingerprint = %v, %v", record, err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("c"), later, later); err != nil || record != nil {
+		t.Fatalf("Reserve of a stale key = %v, %v", record, err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("c"), later, later); err != nil || record == nil {
+		t.Fatalf("Reserve of a key taken over = %v, %v", record, err)
+	}
+
+	// The request that held the key before its lease ran out can no longer
+	// complete or release it.
+	if _, err := db.Exec(`update idempotency_keys set locked_until = now() - interval '1 second' where key = 'key-2'`); err != nil {
+		t.Fatal(err)
+	}
+	retry := uuid.NewString()
+	if record, err = keys.Reserve(ctx, user, method, "key-2", retry, fp("c"), later, later); err != nil || record != nil {
+		t.Fatalf("Reserve by a retry = %v, %v", record, err)
+	}
+	if err := keys.Release(ctx, user, method, "key-2", owner); err != nil {
+		t.Fatal(err)
+	}
+	if err := keys.Complete(ctx, user, method, "key-2", owner, []byte("stale")); !errors.Is(err, idempotency.ErrNotOwner) {
+		t.Fatalf("Complete by the previous owner: %v", err)
+	}
+	if err := keys.Complete(ctx, user, method, "key-2", retry, []byte("retried")); err != nil {
+		t.Fatal(err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-2", owner, fp("c"), later, later); err != nil || record == nil || string(record.Response) != "retried" {
+		t.Fatalf("Reserve after the retry completed = %v, %v", record, err)
+	}
+
+	if _, err := db.Exec(`update idempotency_keys set expires_at = now() - interval '1 second' where key = 'key-1'`); err != nil {
+		t.Fatal(err)
+	}
+	if record, err = keys.Reserve(ctx, user, method, "key-1", owner, fp("d"), later, later); err != nil || record != nil {
+		t.Fatalf("Reserve of an expired key = %v, %v", record, err)
+	}
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type KitchenRepository struct {
+	DB *sql.DB
+}
+
+func NewKitchenRepository(db *sql.DB) *KitchenRepository {
+	return &KitchenRepository{DB: db}
+}
+
+const kitchenColumns = `id, owner_id, name, coalesce(description, ''), coalesce(cuisine_type, ''), coalesce(address, ''), coalesce(phone_number, ''), rating, total_orders, created_at, updated_at, delivery_fee, tax_rate`
+
+func scanKitchen(row interface{ Scan(...any) error }, kitchen *pb.Kitchen, extra ...any) error {
+	var createdAt, updatedAt time.Time
+	dest := []any{&kitchen.Id, &kitchen.OwnerId, &kitchen.Name, &kitchen.Description, &kitchen.CuisineType, &kitchen.Address, &kitchen.PhoneNumber, &kitchen.Rating, &kitchen.TotalOrders, &createdAt, &updatedAt, &kitchen.DeliveryFee, &kitchen.TaxRate}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+	kitchen.CreatedAt = createdAt.Format(time.RFC3339)
+	kitchen.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+func (k *KitchenRepository) CreateKitchen(ctx context.Context, ownerID string, req *pb.CreateKitchenRequest) (*pb.Kitchen, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `insert into kitchens (id, owner_id, name, description, cuisine_type, address, phone_number, delivery_fee, tax_rate)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		returning ` + kitchenColumns
+
+	kitchen := &pb.Kitchen{}
+	row := k.DB.QueryRowContext(ctx, query, uuid.NewString(), ownerID, req.Name, req.Description, req.CuisineType, req.Address, req.PhoneNumber, req.DeliveryFee, req.TaxRate)
+	if err = scanKitchen(row, kitchen); err != nil {
+		log.Error("error inserting kitchen", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("insert kitchen", zap.String("kitchen_id", kitchen.Id), zap.String("owner_id", kitchen.OwnerId))
+	return kitchen, nil
+}
+
+func (k *KitchenRepository) UpdateKitchen(ctx context.Context, req *pb.UpdateKitchenRequest) (*pb.Kitchen, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `update kitchens set
+		name = coalesce(nullif($1, ''), name),
+		description = coalesce(nullif($2, ''), description),
+		delivery_fee = coalesce($4, delivery_fee),
+		tax_rate = coalesce($5, tax_rate),
+		updated_at = now()
+		where id = $3 and deleted_at is null
+		returning ` + kitchenColumns
+
+	kitchen := &pb.Kitchen{}
+	row := k.DB.QueryRowContext(ctx, query, req.Name, req.Description, req.KitchenId, req.DeliveryFee, req.TaxRate)
+	if err = scanKitchen(row, kitchen); err != nil {
+		log.Error("error updating kitchen", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("update kitchen", zap.String("kitchen_id", kitchen.Id))
+	return kitchen, nil
+}
+
+func (k *KitchenRepository) GetKitchen(ctx context.Context, id string) (*pb.Kitchen, error) {
+	query := `select ` + kitchenColumns + ` from kitchens where id = $1 and deleted_at is null`
+
+	kitchen := &pb.Kitchen{}
+	if err := scanKitchen(k.DB.QueryRowContext(ctx, query, id), kitchen); err != nil {
+		return nil, err
+	}
+	return kitchen, nil
+}
+
+func (k *KitchenRepository) ListKitchens(ctx context.Context, req *pb.ListKitchensRequest) (*pb.ListKitchensResponse, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListKitchensResponse{Page: req.Page, Limit: req.Limit}
+
+	if err = k.DB.QueryRowContext(ctx, `select count(*) from kitchens where deleted_at is null`).Scan(&resp.Total); err != nil {
+		log.Error("error counting kitchens", zap.Error(err))
+		return nil, err
+	}
+
+	query := `select ` + kitchenColumns + ` from kitchens where deleted_at is null
+		order by created_at desc, id
+		limit $1 offset $2`
+
+	rows, err := k.DB.QueryContext(ctx, query, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		log.Error("error getting kitchens", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		kitchen := &pb.Kitchen{}
+		if err = scanKitchen(rows, kitchen); err != nil {
+			log.Error("error scanning kitchen", zap.Error(err))
+			return nil, err
+		}
+		resp.Kitchens = append(resp.Kitchens, kitchen)
+	}
+	return resp, rows.Err()
+}
+
+// kitchenDocument and dishDocument are the full-text documents searched by
+// SearchKitchens. The migrations index the same expressions.
+const (
+	kitchenDocument = `setweight(to_tsvector('simple', coalesce(k.name, '')), 'A') ||
+		setweight(to_tsvector('simple', coalesce(k.cuisine_type, '')), 'B') ||
+		setweight(to_tsvector('simple', coalesce(k.description, '')), 'C')`
+	dishDocument = `to_tsvector('simple', coalesce(d.name, '') || ' ' || coalesce(array_to_string(d.ingredients, ' '), ''))`
+)
+
+// SearchKitchens ranks kitchens by how well their name, cuisine and description
+// match the query. Kitchens with a matching dish name or ingredient are
+// included too, ranked slightly below direct matches.
+func (k *KitchenRepository) SearchKitchens(ctx context.Context, req *pb.SearchKitchensRequest) (*pb.SearchKitchensResponse, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchKitchensResponse{Page: req.Page, Limit: req.Limit}
+
+	from := `from kitchens k
+		cross join websearch_to_tsquery('simple', $1) q
+		left join lateral (
+			select max(ts_rank(` + dishDocument + `, q)) as rank
+			from dishes d
+			where d.kitchen_id = k.id and d.deleted_at is null and ` + dishDocument + ` @@ q
+		) dm on $1 <> ''
+		where k.deleted_at is null
+		and ($1 = '' or ` + kitchenDocument + ` @@ q or dm.rank is not null)
+		and ($2 = '' or lower(k.cuisine_type) = lower($2))
+		and k.rating >= $3`
+
+	if err = k.DB.QueryRowContext(ctx, `select count(*) `+from, req.Query, req.CuisineType, req.Rating).Scan(&resp.Total); err != nil {
+		log.Error("error counting kitchens", zap.Error(err))
+		return nil, err
+	}
+
+	query := `select k.id, k.owner_id, k.name, coalesce(k.description, ''), coalesce(k.cuisine_type, ''), coalesce(k.address, ''), coalesce(k.phone_number, ''), k.rating, k.total_orders, k.created_at, k.updated_at, k.delivery_fee, k.tax_rate,
+		case when $1 = '' then 0 else ts_rank(` + kitchenDocument + `, q) + 0.5 * coalesce(dm.rank, 0) end as rank
+		` + from + `
+		order by rank desc, k.rating desc, k.id
+		limit $4 offset $5`
+
+	rows, err := k.DB.QueryContext(ctx, query, req.Query, req.CuisineType, req.Rating, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		log.Error("error searching kitchens", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		kitchen := &pb.Kitchen{}
+		var rank float64
+		if err = scanKitchen(rows, kitchen, &rank); err != nil {
+			log.Error("error scanning kitchen", zap.Error(err))
+			return nil, err
+		}
+		resp.Kitchens = append(resp.Kitchens, kitchen)
+	}
+	return resp, rows.Err()
+}
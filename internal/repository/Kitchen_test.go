@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestKitchens(t *testing.T) {
+	db := newTestDB(t)
+	kitchens := NewKitchenRepository(db)
+	ctx := context.Background()
+	chef := seedUser(t, db, "chef")
+
+	pasta, err := kitchens.CreateKitchen(ctx, chef, &authpb.CreateKitchenRequest{Name: "Pasta Place", CuisineType: "Italian", Description: "Fresh pasta daily", DeliveryFee: proto.Float64(3.5)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pasta.OwnerId != chef || pasta.DeliveryFee == nil || *pasta.DeliveryFee != 3.5 || pasta.TaxRate != nil {
+		t.Fatalf("created kitchen %v", pasta)
+	}
+	sushi, err := kitchens.CreateKitchen(ctx, chef, &authpb.CreateKitchenRequest{Name: "Sushi Bar", CuisineType: "Japanese"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := kitchens.UpdateKitchen(ctx, &authpb.UpdateKitchenRequest{KitchenId: pasta.Id, Description: "Handmade pasta", TaxRate: proto.Float64(0.2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "Pasta Place" || updated.Description != "Handmade pasta" || *updated.DeliveryFee != 3.5 || *updated.TaxRate != 0.2 {
+		t.Fatalf("updated kitchen %v", updated)
+	}
+
+	got, err := kitchens.GetKitchen(ctx, sushi.Id)
+	if err != nil || got.Name != "Sushi Bar" {
+		t.Fatalf("GetKitchen = %v, %v", got, err)
+	}
+	if _, err := kitchens.GetKitchen(ctx, "00000000-0000-0000-0000-000000000000"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetKitchen of a missing kitchen: %v", err)
+	}
+
+	list, err := kitchens.ListKitchens(ctx, &authpb.ListKitchensRequest{Page: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 2 || len(list.Kitchens) != 1 || list.Kitchens[0].Id != sushi.Id {
+		t.Fatalf("ListKitchens = %v", list)
+	}
+
+	// Sushi Bar only matches through one of its dishes.
+	orders, _ := newOrderRepository(db)
+	if _, err := orders.CreateDish(ctx, &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: sushi.Id, Name: "Salmon nigiri", Ingredients: []string{"rice", "salmon"}, Available: true}}); err != nil {
+		t.Fatal(err)
+	}
+	found, err := kitchens.SearchKitchens(ctx, &authpb.SearchKitchensRequest{Query: "salmon", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Total != 1 || found.Kitchens[0].Id != sushi.Id {
+		t.Fatalf("search by ingredient = %v", found.Kitchens)
+	}
+	found, err = kitchens.SearchKitchens(ctx, &authpb.SearchKitchensRequest{Query: "pasta", CuisineType: "italian", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Total != 1 || found.Kitchens[0].Id != pasta.Id {
+		t.Fatalf("search by name = %v", found.Kitchens)
+	}
+	found, err = kitchens.SearchKitchens(ctx, &authpb.SearchKitchensRequest{CuisineType: "Thai", Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.Total != 0 || len(found.Kitchens) != 0 {
+		t.Fatalf("search for a missing cuisine = %v", found.Kitchens)
+	}
+}
+
+func TestKitchenRatings(t *testing.T) {
+	db := newTestDB(t)
+	kitchens := NewKitchenRepository(db)
+	orders, _ := newOrderRepository(db)
+	ctx := context.Background()
+	customer := seedUser(t, db, "customer")
+
+	review := func(kitchenID string, ratings ...float32) {
+		t.Helper()
+		dish := createDish(t, orders, kitchenID, "Soup", 10)
+		for _, rating := range ratings {
+			order := createOrder(t, orders, customer, kitchenID, &pb.OrderItem{DishId: dish.Id, Quantity: 1})
+			if _, err := orders.CreateReview(ctx, &pb.CreateReviewRequest{Review: &pb.Review{OrderId: order.Id, UserId: customer, Rating: rating}}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	good := seedKitchen(t, db, seedUser(t, db, "chef"))
+	fair := seedKitchen(t, db, seedUser(t, db, "chef"))
+	unrated := seedKitchen(t, db, seedUser(t, db, "chef"))
+	review(good, 5, 4)
+	review(fair, 3)
+
+	got, err := kitchens.GetKitchen(ctx, good)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Rating != 4.5 {
+		t.Fatalf("rating after reviews of 5 and 4 = %v, want 4.5", got.Rating)
+	}
+
+	for _, tc := range []struct {
+		minimum float32
+		want    []string
+	}{
+		{0, []string{good, fair, unrated}},
+		{3, []string{good, fair}},
+		{4.5, []string{good}},
+		{4.6, nil},
+	} {
+		found, err := kitchens.SearchKitchens(ctx, &authpb.SearchKitchensRequest{Rating: tc.minimum, Page: 1, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, kitchen := range found.Kitchens {
+			ids = append(ids, kitchen.Id)
+		}
+		if int(found.Total) != len(tc.want) || strings.Join(ids, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("kitchens rated %v or more = %v, want %v best first", tc.minimum, ids, tc.want)
+		}
+	}
+}
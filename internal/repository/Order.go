@@ -2,80 +2,191 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
-	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	pq "github.com/lib/pq"
-	"github.com/spf13/cast"
 	"go.uber.org/zap"
 
+	"Github.com/LocalEats/Order-Service/internal/apperr"
 	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
 
 	"database/sql"
 )
 
 type OrderRepository struct {
-	DB *sql.DB
+	DB       *sql.DB
+	Fees     pricing.Fees
+	Payments payment.Provider
+	Pages    *pagetoken.Codec
 }
 
-func NewOrderRepository(db *sql.DB) *OrderRepository {
-	return &OrderRepository{DB: db}
+func NewOrderRepository(db *sql.DB, fees pricing.Fees, payments payment.Provider, pages *pagetoken.Codec) *OrderRepository {
+	return &OrderRepository{DB: db, Fees: fees, Payments: payments, Pages: pages}
 }
 
-func (o *OrderRepository) CreateDish(ctx context.Context, req *pb.CreateDishRequest) (*pb.CreateDishResponse, error) {
+const dishColumns = `id, kitchen_id, name, coalesce(description, ''), price, coalesce(category, ''), ingredients, allergens, coalesce(nutrition_info, ''),
+	dietary_info, available, created_at, updated_at`
 
-	log, err := l.NewLogger()
+func scanDish(row interface{ Scan(...any) error }, dish *pb.Dish) error {
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &dish.Price, &dish.Category, pq.Array(&dish.Ingredients), pq.Array(&dish.Allergens), &dish.NutritionInfo,
+		pq.Array(&dish.DietaryInfo), &dish.Available, &createdAt, &updatedAt); err != nil {
+		return err
+	}
+	dish.CreatedAt = createdAt.Format(time.RFC3339)
+	dish.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// offset returns the row offset of a 1-based page.
+func offset(page, limit int32) int32 {
+	if page < 1 {
+		return 0
+	}
+	return (page - 1) * limit
+}
+
+// listPage is one page of a listing ordered by (created_at, id). A page starts
+// either after the row named by a page token or, without one, at the offset of
+// its 1-based number.
+type listPage struct {
+	scope  string
+	cursor *pagetoken.Cursor
+	number int32
+	limit  int32
+}
+
+func (o *OrderRepository) startPage(scope, token string, number, limit int32) (listPage, error) {
+	cursor, err := o.Pages.Decode(scope, token)
 	if err != nil {
-		return nil, err
+		return listPage{}, err
 	}
+	if cursor != nil {
+		number = cursor.Page
+	}
+	return listPage{scope: scope, cursor: cursor, number: number, limit: limit}, nil
+}
 
-	resp := &pb.CreateDishResponse{}
-	dish := &pb.Dish{}
+// after returns the created_at and id the page starts after, both nil when
+// it starts at an offset.
+func (p listPage) after() (any, any) {
+	if p.cursor == nil {
+		return nil, nil
+	}
+	return p.cursor.CreatedAt, p.cursor.ID
+}
+
+func (p listPage) offset() int32 {
+	if p.cursor != nil {
+		return 0
+	}
+	return offset(p.number, p.limit)
+}
+
+// fetch is the query's row limit: one row more than the page, which tells
+// whether another page follows. 0 fetches every row.
+func (p listPage) fetch() int32 {
+	if p.limit <= 0 {
+		return 0
+	}
+	return p.limit + 1
+}
+
+// full reports whether n rows fill the page.
+func (p listPage) full(n int) bool {
+	return p.limit > 0 && n >= int(p.limit)
+}
 
-	query := `insert into dishes (id, kithen_id,name, description,price, category,allergens, nutrition_info, dietary_info,ingredients,available) values ($1, $2, $3, $4, $5, $6, $7, $8,$9,$10,$11)`
+// next returns the token of the following page, which starts after last.
+func (p listPage) next(pages *pagetoken.Codec, last pagetoken.Cursor) string {
+	last.Page = max(p.number, 1) + 1
+	return pages.Encode(p.scope, last)
+}
 
-	id := uuid.NewString()
-	req.Dish.Id = id
+// keyedRows reads the listing's keyset columns, selected after the columns the
+// scan helpers read, so the helpers can read the row while the listing keeps
+// its position.
+type keyedRows struct {
+	*sql.Rows
+	keys []any
+}
 
-	dish.CreatedAt = cast.ToString(time.Now())
-	row := o.DB.QueryRowContext(ctx, query, req.Dish.Id, req.Dish.KitchenId, req.Dish.Name, req.Dish.Description, req.Dish.Price, req.Dish.Category, req.Dish.Allergens, req.Dish.NutritionInfo, req.Dish.DietaryInfo, req.Dish.Ingredients, req.Dish.Available)
-	err = row.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &dish.Price, &dish.Category, pq.Array(req.Dish.Ingredients), pq.Array(req.Dish.Allergens), &req.Dish.NutritionInfo, &dish.Available, pq.Array(req.Dish.DietaryInfo))
+func (r keyedRows) Scan(dest ...any) error {
+	return r.Rows.Scan(append(dest, r.keys...)...)
+}
+
+func (o *OrderRepository) CreateDish(ctx context.Context, req *pb.CreateDishRequest) (*pb.CreateDishResponse, error) {
+	log, err := l.NewLogger()
 	if err != nil {
+		return nil, err
+	}
+
+	var exists bool
+	if err = o.DB.QueryRowContext(ctx, `select exists(select 1 from kitchens where id::text = $1 and deleted_at is null)`, req.Dish.KitchenId).Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrKitchenNotFound
+	}
+
+	query := `insert into dishes (id, kitchen_id, name, description, price, category, ingredients, allergens, nutrition_info, dietary_info, available)
+		values ($1, $2, $3, nullif($4, ''), $5, nullif($6, ''), $7, $8, nullif($9, ''), $10, $11)
+		returning ` + dishColumns
+
+	dish := &pb.Dish{}
+	row := o.DB.QueryRowContext(ctx, query, uuid.NewString(), req.Dish.KitchenId, req.Dish.Name, req.Dish.Description, req.Dish.Price, req.Dish.Category,
+		pq.Array(nonNil(req.Dish.Ingredients)), pq.Array(nonNil(req.Dish.Allergens)), req.Dish.NutritionInfo, pq.Array(nonNil(req.Dish.DietaryInfo)), req.Dish.Available)
+	if err = scanDish(row, dish); err != nil {
 		log.Error("error inserting dish", zap.Error(err))
-		return resp, err
+		return nil, err
 	}
-	resp.Dish = dish
-	log.Info("insert dish", zap.Any("dish", dish))
-	return resp, nil
+
+	log.Info("insert dish", zap.String("dish_id", dish.Id))
+	return &pb.CreateDishResponse{Dish: dish}, nil
 }
 
-func (o *OrderRepository) UpdateDish(ctx context.Context, req *pb.UpdateDishRequest) (*pb.UpdateDishResponse, error) {
+// nonNil keeps nil slices from being stored as NULL arrays.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
 
+func (o *OrderRepository) UpdateDish(ctx context.Context, req *pb.UpdateDishRequest) (*pb.UpdateDishResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	query := `update dishes set name=$1, price=$2,available=$3 where id=$4 and deleted_at is null`
+	query := `update dishes set name = $1, price = $2, available = $3, updated_at = now()
+		where id::text = $4 and deleted_at is null
+		returning ` + dishColumns
 
-	resp := &pb.UpdateDishResponse{}
 	dish := &pb.Dish{}
-
-	dish.UpdatedAt = cast.ToString(time.Now())
-
-	row := o.DB.QueryRowContext(ctx, query, req.Dish.Name, req.Dish.Price, req.Dish.Available, req.Dish.Id)
-	err = row.Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &dish.Price, &dish.Category, &dish.Ingredients, &dish.Available, &dish.CreatedAt)
+	err = scanDish(o.DB.QueryRowContext(ctx, query, req.Dish.Name, req.Dish.Price, req.Dish.Available, req.Dish.Id), dish)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDishNotFound
+	}
 	if err != nil {
 		log.Error("error updating dish", zap.Error(err))
-		return resp, err
+		return nil, err
 	}
-	resp.Dish = dish
 
-	log.Info("updated dish", zap.Any("dish", dish))
-	return resp, nil
+	log.Info("updated dish", zap.String("dish_id", dish.Id))
+	return &pb.UpdateDishResponse{Dish: dish}, nil
 }
 
 func (o *OrderRepository) DeleteDish(ctx context.Context, req *pb.DeleteDishRequest) (*pb.DeleteDishResponse, error) {
@@ -83,368 +194,887 @@ func (o *OrderRepository) DeleteDish(ctx context.Context, req *pb.DeleteDishRequ
 	if err != nil {
 		return nil, err
 	}
-	query := `update dishes set deleted_at=$1 where id=$2`
 
-	resp := &pb.DeleteDishResponse{}
-
-	_, err = o.DB.ExecContext(ctx, query, req.DishId)
+	result, err := o.DB.ExecContext(ctx, `update dishes set deleted_at = now() where id::text = $1 and deleted_at is null`, req.DishId)
 	if err != nil {
 		log.Error("error deleting dish", zap.Error(err))
-		return resp, err
+		return nil, err
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if deleted == 0 {
+		return nil, ErrDishNotFound
 	}
-	resp.Message = cast.ToString(gin.H{"message": "Dish successfully deleted"})
 
-	log.Info("dish successfully deleted")
-	return resp, nil
+	log.Info("dish successfully deleted", zap.String("dish_id", req.DishId))
+	return &pb.DeleteDishResponse{Message: "Dish successfully deleted"}, nil
 }
 
-func (o *OrderRepository) GetDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
+// Values of ListDishesRequest.sort_by. Without one dishes are listed oldest
+// first.
+const (
+	DishSortNewest     = "newest"
+	DishSortPriceAsc   = "price_asc"
+	DishSortPriceDesc  = "price_desc"
+	DishSortRating     = "rating"
+	DishSortPopularity = "popularity"
+)
 
+var DishSorts = []string{DishSortNewest, DishSortPriceAsc, DishSortPriceDesc, DishSortRating, DishSortPopularity}
+
+var ErrUnknownDishSort = apperr.Invalid("sort_by", "sort_by must be one of "+strings.Join(DishSorts, ", "))
+
+// dishSorts maps each sort to its numeric sort key, a column of dishListing,
+// and direction. Dishes with the same key are ordered by (created_at, id) in
+// the same direction, so every sort pages by keyset.
+var dishSorts = map[string]struct {
+	key  string
+	desc bool
+}{
+	"":                 {},
+	DishSortNewest:     {desc: true},
+	DishSortPriceAsc:   {key: "price::float8"},
+	DishSortPriceDesc:  {key: "price::float8", desc: true},
+	DishSortRating:     {key: "rating", desc: true},
+	DishSortPopularity: {key: "popularity", desc: true},
+}
+
+// dishListing adds the rating and popularity sort keys to dishes: the average
+// rating of reviewed orders that included the dish, and the quantity ordered
+// in total, as GetDishRecommendations counts it.
+const dishListing = `(
+		select d.*,
+			coalesce((select avg(r.rating) from reviews r join order_items i on i.order_id = r.order_id where i.dish_id = d.id), 0)::float8 as rating,
+			coalesce((select sum(i.quantity) from order_items i where i.dish_id = d.id), 0)::float8 as popularity
+		from dishes d
+	) dishes`
+
+// dishFilters applies a normalized ListDishesRequest. Tags are compared
+// case-insensitively, and a dish that lists any excluded allergen is never
+// returned.
+const dishFilters = ` where deleted_at is null
+	and ($1 = '' or kitchen_id = nullif($1, '')::uuid)
+	and ($2 = '' or lower(category) = $2)
+	and ($3::numeric = 0 or price >= $3)
+	and ($4::numeric = 0 or price <= $4)
+	and (not $5::boolean or available)
+	and array(select lower(trim(t)) from unnest(dietary_info) t) @> $6::text[]
+	and not (array(select lower(trim(a)) from unnest(allergens) a) && $7::text[])`
+
+// NormalizeDishFilters trims and lower-cases the category and tag filters of
+// req, and sorts and dedupes the tags, so that equal filters match the same
+// dishes and share page tokens.
+func NormalizeDishFilters(req *pb.ListDishesRequest) {
+	req.Category = strings.ToLower(strings.TrimSpace(req.Category))
+	req.DietaryInfo = normalizeTags(req.DietaryInfo)
+	req.ExcludeAllergens = normalizeTags(req.ExcludeAllergens)
+}
+
+func normalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+			normalized = append(normalized, tag)
+		}
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// DishScope is the page token scope of a normalized ListDishes query, so a
+// token only pages through the filters and sort it was issued for.
+func DishScope(req *pb.ListDishesRequest) string {
+	return pagetoken.Scope("dishes", req.KitchenId, req.Category,
+		strconv.FormatFloat(req.MinPrice, 'f', -1, 64), strconv.FormatFloat(req.MaxPrice, 'f', -1, 64), strconv.FormatBool(req.AvailableOnly),
+		strings.Join(req.DietaryInfo, ","), strings.Join(req.ExcludeAllergens, ","), req.SortBy)
+}
+
+// GetDishes lists the dishes matching the request's filters. Without a
+// kitchen it lists the whole catalogue.
+func (o *OrderRepository) GetDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &pb.ListDishesResponse{}
+	sortBy, ok := dishSorts[req.SortBy]
+	if !ok {
+		return nil, ErrUnknownDishSort
+	}
+	NormalizeDishFilters(req)
+	page, err := o.startPage(DishScope(req), req.PageToken, req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDishesResponse{Page: page.number, Limit: req.Limit}
+
+	args := []any{req.KitchenId, req.Category, req.MinPrice, req.MaxPrice, req.AvailableOnly, pq.Array(req.DietaryInfo), pq.Array(req.ExcludeAllergens)}
+	if err = o.DB.QueryRowContext(ctx, `select count(*) from dishes`+dishFilters, args...).Scan(&resp.Total); err != nil {
+		log.Error("error counting dishes", zap.Error(err))
+		return nil, err
+	}
 
-	var offset int32
+	value, keys, direction, op := "0::float8", "created_at, id", "", ">"
+	if sortBy.key != "" {
+		value, keys = sortBy.key, sortBy.key+", "+keys
+	}
+	if sortBy.desc {
+		direction, op = " desc", "<"
+	}
 
-	query := "select id, name, price, category, available from dishes where deleted_at is null order by id"
-	if req.Page > 0 {
-		offset = (req.Page * req.Limit) - req.Limit
+	args = append(args, page.fetch(), page.offset())
+	keyset := ""
+	if page.cursor != nil {
+		bound := "$10::timestamptz, $11::uuid"
+		args = append(args, page.cursor.CreatedAt, page.cursor.ID)
+		if sortBy.key != "" {
+			bound = "$12::float8, " + bound
+			args = append(args, page.cursor.Value)
+		}
+		keyset = `
+		and (` + keys + `) ` + op + ` (` + bound + `)`
 	}
-	query += " limit " + cast.ToString(req.Limit) + "offset " + cast.ToString(offset)
 
-	var dishes []*pb.Dish
+	// A limit of 0 lists every dish; limit null is the same as limit all.
+	query := `select ` + dishColumns + `, created_at, ` + value + `
+		from ` + dishListing + dishFilters + keyset + `
+		order by ` + strings.ReplaceAll(keys, ",", direction+",") + direction + `
+		limit nullif($8, 0) offset $9`
 
-	rows, err := o.DB.QueryContext(ctx, query, req.KitchenId, req.Limit, req.Page)
+	rows, err := o.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		log.Error("error getting dishes", zap.Error(err))
-		return resp, err
+		return nil, err
 	}
 	defer rows.Close()
 
+	var last pagetoken.Cursor
 	for rows.Next() {
-		var dish pb.Dish
-
-		err := rows.Scan(&dish.Id, &dish.Name, &dish.Price, &dish.Category, &dish.Available)
-		if err != nil {
-			log.Error("error getting dish", zap.Error(err))
-			return resp, err
+		if page.full(len(resp.Dishes)) {
+			resp.NextPageToken = page.next(o.Pages, last)
+			break
+		}
+		dish := &pb.Dish{}
+		if err = scanDish(keyedRows{rows, []any{&last.CreatedAt, &last.Value}}, dish); err != nil {
+			log.Error("error scanning dish", zap.Error(err))
+			return nil, err
 		}
-		dishes = append(dishes, &dish)
+		last.ID = dish.Id
+		resp.Dishes = append(resp.Dishes, dish)
 	}
-	resp.Dishes = dishes
-
-	log.Info("Get Dish", zap.Any("dishes", dishes))
-	return resp, nil
+	return resp, rows.Err()
 }
 
-func (o *OrderRepository) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
+var (
+	ErrOrderNotFound     = apperr.New(apperr.NotFound, "order not found")
+	ErrInvalidTransition = apperr.New(apperr.Precondition, "invalid order status transition")
+	// ErrCancelViaStatus keeps cancellations on CancelOrder, which enforces
+	// the cancellation windows and refunds the order's payments.
+	ErrCancelViaStatus = apperr.Invalid("status", "orders are cancelled with CancelOrder, not UpdateOrderStatus")
+)
 
+// UpdateOrderStatus moves the order to req.Status if the lifecycle allows it
+// and records the change in the order's status history.
+func (o *OrderRepository) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest, actorID string) (*pb.UpdateOrderStatusResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
+	if req.Status == orderstatus.Cancelled {
+		return nil, ErrCancelViaStatus
+	}
 
-	query := "update dishes set status=$1 where id=$2"
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
-	row := o.DB.QueryRowContext(ctx, query, req.OrderId, req.Status)
-	err = row.Scan(&req.OrderId, &req.Status)
+	updatedAt, err := transitionOrder(ctx, tx, req.OrderId, req.Status, actorID, req.Reason)
 	if err != nil {
-		log.Error("error updating dish status", zap.Error(err))
+		log.Error("error updating order status", zap.String("order_id", req.OrderId), zap.Error(err))
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
-	resp := &pb.UpdateOrderStatusResponse{
+
+	log.Info("update order status", zap.String("order_id", req.OrderId), zap.String("status", req.Status))
+	return &pb.UpdateOrderStatusResponse{
 		OrderId:   req.OrderId,
 		Status:    req.Status,
-		UpdatedAt: cast.ToString(time.Now()),
-	}
-	log.Info("update dish status", zap.String("status", req.Status))
-	return resp, nil
+		UpdatedAt: updatedAt.Format(time.RFC3339),
+	}, nil
 }
 
-func (o *OrderRepository) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
-	resp := &pb.CreateOrderResponse{}
-	order := &pb.Order{}
+// transitionOrder locks the order, checks the transition against the
+// lifecycle and appends it to order_status_history.
+func transitionOrder(ctx context.Context, tx *sql.Tx, orderID, to, actorID, reason string) (time.Time, error) {
+	var from string
+	err := tx.QueryRowContext(ctx, `select status from orders where id = $1 and deleted_at is null for update`, orderID).Scan(&from)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, ErrOrderNotFound
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !orderstatus.CanTransition(from, to) {
+		return time.Time{}, fmt.Errorf("%w: %s to %s", ErrInvalidTransition, from, to)
+	}
+	if from == orderstatus.Pending && to != orderstatus.Cancelled && to != orderstatus.Rejected {
+		if err := requirePayment(ctx, tx, orderID); err != nil {
+			return time.Time{}, err
+		}
+	}
 
-	log, err := l.NewLogger()
+	var updatedAt time.Time
+	err = tx.QueryRowContext(ctx, `update orders set status = $1, updated_at = now() where id = $2 returning updated_at`, to, orderID).Scan(&updatedAt)
 	if err != nil {
-		return nil, err
+		return time.Time{}, err
 	}
+	return updatedAt, recordStatusChange(ctx, tx, orderID, from, to, actorID, reason)
+}
 
-	id := uuid.NewString()
-	req.Order.Id = id
+func recordStatusChange(ctx context.Context, tx *sql.Tx, orderID, from, to, actorID, reason string) error {
+	_, err := tx.ExecContext(ctx, `insert into order_status_history (id, order_id, from_status, to_status, actor_id, reason) values ($1, $2, nullif($3, ''), $4, nullif($5, '')::uuid, nullif($6, ''))`,
+		uuid.NewString(), orderID, from, to, actorID, reason)
+	return err
+}
+
+// loadStatusHistory attaches the status timeline, oldest first, to each order.
+func (o *OrderRepository) loadStatusHistory(ctx context.Context, orders []*pb.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
 
-	query := "INSERT INTO orders (id, user_id, kitchen_id, delivery_address, delivery_time, status, created_at, total_amount) values ($1,$2,$3,$4,$5,$6,$7,$8)"
+	byID := make(map[string]*pb.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byID[order.Id] = order
+		ids = append(ids, order.Id)
+	}
 
-	row := o.DB.QueryRowContext(ctx, query, req.Order.Id, req.Order.UserId, req.Order.KitchenId, req.Order.DeliveryAddress, req.Order.DeliveryTime, req.Order.Status, req.Order.CreatedAt, req.Order.TotalAmount)
+	query := `select order_id, coalesce(from_status, ''), to_status, coalesce(actor_id::text, ''), coalesce(reason, ''), created_at
+		from order_status_history where order_id::text = any($1)
+		order by created_at, id`
 
-	err = row.Scan(&order.Id, &order.UserId, &order.KitchenId, &order.DeliveryAddress, &order.DeliveryTime, &order.Status, &order.CreatedAt, &order.TotalAmount)
+	rows, err := o.DB.QueryContext(ctx, query, pq.Array(ids))
 	if err != nil {
-		log.Error("error inserting order", zap.Error(err))
-		return nil, err
+		return err
 	}
-	resp.Order = order
+	defer rows.Close()
 
-	log.Info("insert order", zap.Any("order", order))
-	return resp, nil
+	for rows.Next() {
+		var orderID string
+		var createdAt time.Time
+		change := &pb.OrderStatusChange{}
+		if err := rows.Scan(&orderID, &change.FromStatus, &change.ToStatus, &change.ActorId, &change.Reason, &createdAt); err != nil {
+			return err
+		}
+		change.CreatedAt = createdAt.Format(time.RFC3339)
+		byID[orderID].StatusHistory = append(byID[orderID].StatusHistory, change)
+	}
+	return rows.Err()
+}
 
+// GetOrderByID returns a single order with its items.
+func (o *OrderRepository) GetOrderByID(ctx context.Context, id string) (*pb.Order, error) {
+	order := &pb.Order{}
+	err := scanOrder(o.DB.QueryRowContext(ctx, `select `+orderColumns+` from orders where id = $1 and deleted_at is null`, id), order)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = o.loadOrderItems(ctx, []*pb.Order{order}); err != nil {
+		return nil, err
+	}
+	return order, nil
 }
 
-func (o *OrderRepository) listOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+var ErrCancellationWindow = apperr.New(apperr.Precondition, "order can no longer be cancelled")
+
+// CancelOrder cancels the order if its current status is one of allowedFrom
+// and refunds or voids every outstanding payment made for it. The provider is
+// called inside the same transaction, so a failed refund leaves the order
+// untouched.
+func (o *OrderRepository) CancelOrder(ctx context.Context, orderID, actorID, reason string, allowedFrom []string) (*pb.CancelOrderResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &pb.ListOrdersResponse{}
-	kit := &pb.Kitchen{}
-
-	var offset int32
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
-	query := "select u.id, k.kitchen_name, u.total_amount, u.status, u.delivery_time from order u, kitchen k , where deleted_at is null order by id"
-	if req.Page > 0 {
-		offset = (req.Page * req.Limit) - req.Limit
+	var current string
+	err = tx.QueryRowContext(ctx, `select status from orders where id = $1 and deleted_at is null for update`, orderID).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(allowedFrom, current) {
+		return nil, fmt.Errorf("%w: order is %s", ErrCancellationWindow, current)
 	}
-	query += " limit " + cast.ToString(req.Limit) + "offset " + cast.ToString(offset)
 
-	var orders []*pb.Order
+	if _, err = transitionOrder(ctx, tx, orderID, orderstatus.Cancelled, actorID, reason); err != nil {
+		log.Error("error cancelling order", zap.String("order_id", orderID), zap.Error(err))
+		return nil, err
+	}
 
-	rows, err := o.DB.QueryContext(ctx, query, req.UserId, req.KitchenId, req.Limit, req.Page)
+	refunds, err := o.refundOrderPayments(ctx, tx, orderID, actorID, reason)
 	if err != nil {
-		log.Error("error getting dishes", zap.Error(err))
-		return resp, err
+		log.Error("error refunding order", zap.String("order_id", orderID), zap.Error(err))
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
 	}
+
+	order, err := o.GetOrderByID(ctx, orderID)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+
+	log.Info("cancel order", zap.String("order_id", orderID), zap.Int("refunds", len(refunds)))
+	return &pb.CancelOrderResponse{Order: order, Refunds: refunds}, nil
+}
+
+var (
+	ErrNoOrderItems     = apperr.Invalid("order.items", "order has no items")
+	ErrInvalidQuantity  = apperr.Invalid("order.items.quantity", "item quantity must be positive")
+	ErrDishNotFound     = apperr.New(apperr.NotFound, "dish not found")
+	ErrDishUnavailable  = apperr.New(apperr.Precondition, "dish is not available")
+	ErrDishWrongKitchen = apperr.Invalid("order.items.dish_id", "dish belongs to a different kitchen")
+	ErrKitchenNotFound  = apperr.New(apperr.NotFound, "kitchen not found")
+	ErrInvalidDiscount  = apperr.Invalid("order.discount_code", "discount code is invalid or expired")
+	ErrTotalMismatch    = apperr.Invalid("order.total_amount", "total_amount does not match the computed total")
+)
+
+const orderColumns = `id, user_id, kitchen_id, total_amount, status, coalesce(delivery_address, ''), delivery_time, created_at, updated_at,
+	coalesce(discount_code, ''), subtotal, delivery_fee, tax_amount, discount_amount`
+
+func scanOrder(row interface{ Scan(...any) error }, order *pb.Order) error {
+	var deliveryTime sql.NullTime
+	var createdAt, updatedAt time.Time
+	breakdown := &pb.PriceBreakdown{}
+	if err := row.Scan(&order.Id, &order.UserId, &order.KitchenId, &order.TotalAmount, &order.Status, &order.DeliveryAddress, &deliveryTime, &createdAt, &updatedAt,
+		&order.DiscountCode, &breakdown.Subtotal, &breakdown.DeliveryFee, &breakdown.Tax, &breakdown.Discount); err != nil {
+		return err
+	}
+	breakdown.Total = order.TotalAmount
+	order.PriceBreakdown = breakdown
+	if deliveryTime.Valid {
+		order.DeliveryTime = deliveryTime.Time.Format(time.RFC3339)
 	}
+	order.CreatedAt = createdAt.Format(time.RFC3339)
+	order.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// snapshotItems copies the current name and price of every ordered dish into
+// the items, rejecting dishes that cannot be ordered from kitchenID.
+func snapshotItems(ctx context.Context, tx *sql.Tx, kitchenID string, items []*pb.OrderItem) error {
+	if len(items) == 0 {
+		return ErrNoOrderItems
+	}
+
+	dishIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return fmt.Errorf("%w: dish %s", ErrInvalidQuantity, item.DishId)
+		}
+		dishIDs = append(dishIDs, item.DishId)
+	}
+
+	type dishSnapshot struct {
+		kitchenID string
+		name      string
+		price     float64
+		available bool
+	}
+	dishes := map[string]dishSnapshot{}
 
+	rows, err := tx.QueryContext(ctx, `select id, kitchen_id, name, price, available from dishes where id::text = any($1) and deleted_at is null for share`, pq.Array(dishIDs))
+	if err != nil {
+		return err
+	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var order pb.Order
-
-		err := rows.Scan(&order.Id, &kit.Name, &order.TotalAmount, &order.Status, &order.DeliveryTime)
-		if err != nil {
-			log.Error("error getting dishes", zap.Error(err))
-			return resp, err
+		var id string
+		var dish dishSnapshot
+		if err := rows.Scan(&id, &dish.kitchenID, &dish.name, &dish.price, &dish.available); err != nil {
+			return err
 		}
-		orders = append(orders, &order)
+		dishes[id] = dish
+	}
+	if err := rows.Err(); err != nil {
+		return err
 	}
-	resp.Orders = orders
 
-	log.Info("Get Order", zap.Any("Orders", orders))
-	return resp, nil
+	for _, item := range items {
+		dish, ok := dishes[item.DishId]
+		switch {
+		case !ok:
+			return fmt.Errorf("%w: %s", ErrDishNotFound, item.DishId)
+		case !dish.available:
+			return fmt.Errorf("%w: %s", ErrDishUnavailable, item.DishId)
+		case dish.kitchenID != kitchenID:
+			return fmt.Errorf("%w: %s", ErrDishWrongKitchen, item.DishId)
+		}
+		item.Name = dish.name
+		item.Price = dish.price
+	}
+	return nil
 }
 
-func (o *OrderRepository) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
-
+func (o *OrderRepository) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &pb.GetOrderResponse{}
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	items := req.Order.Items
+	if err = snapshotItems(ctx, tx, req.Order.KitchenId, items); err != nil {
+		log.Error("error validating order items", zap.Error(err))
+		return nil, err
+	}
 
-	query := "select d.id, u.user_name, d.total_amount, d.status, d.delivery_time from orders d, users u, where kitchen_id=$1"
+	breakdown, discountCode, err := o.quoteOrder(ctx, tx, req.Order)
+	if err != nil {
+		log.Error("error pricing order", zap.Error(err))
+		return nil, err
+	}
+	if req.Order.TotalAmount != 0 && !pricing.Equal(req.Order.TotalAmount, breakdown.Total) {
+		return nil, fmt.Errorf("%w: expected %.2f", ErrTotalMismatch, breakdown.Total)
+	}
 
-	var offset int32
+	query := `insert into orders (id, user_id, kitchen_id, delivery_address, delivery_time, status,
+			total_amount, subtotal, delivery_fee, tax_amount, discount_amount, discount_code)
+		values ($1, $2, $3, $4, nullif($5, '')::timestamptz, $6, $7, $8, $9, $10, $11, nullif($12, ''))
+		returning ` + orderColumns
 
-	if req.Page > 0 {
-		offset = (req.Page * req.Limit) - req.Limit
+	order := &pb.Order{}
+	row := tx.QueryRowContext(ctx, query, uuid.NewString(), req.Order.UserId, req.Order.KitchenId, req.Order.DeliveryAddress, req.Order.DeliveryTime, orderstatus.Pending,
+		breakdown.Total, breakdown.Subtotal, breakdown.DeliveryFee, breakdown.Tax, breakdown.Discount, discountCode)
+	if err = scanOrder(row, order); err != nil {
+		log.Error("error inserting order", zap.Error(err))
+		return nil, err
+	}
+
+	for _, item := range items {
+		_, err = tx.ExecContext(ctx, `insert into order_items (id, order_id, dish_id, name, price, quantity) values ($1, $2, $3, $4, $5, $6)`,
+			uuid.NewString(), order.Id, item.DishId, item.Name, item.Price, item.Quantity)
+		if err != nil {
+			log.Error("error inserting order item", zap.Error(err))
+			return nil, err
+		}
+	}
+	if err = recordStatusChange(ctx, tx, order.Id, "", orderstatus.Pending, order.UserId, ""); err != nil {
+		log.Error("error recording order status", zap.Error(err))
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
 	}
-	query += " limit " + cast.ToString(req.Limit) + "offset " + cast.ToString(offset)
+	order.Items = items
 
-	var orders []*pb.Order
+	log.Info("insert order", zap.String("order_id", order.Id), zap.Int("items", len(items)))
+	return &pb.CreateOrderResponse{Order: order}, nil
+}
 
-	rows, err := o.DB.QueryContext(ctx, query, req.KitchenID)
+// quoteOrder prices the snapshotted items with the kitchen's delivery fee and
+// tax rate, falling back to the configured defaults, and applies the order's
+// discount code if it has one. It returns the canonical discount code.
+func (o *OrderRepository) quoteOrder(ctx context.Context, tx *sql.Tx, order *pb.Order) (pricing.Breakdown, string, error) {
+	var deliveryFee, taxRate float64
+	err := tx.QueryRowContext(ctx, `select coalesce(delivery_fee, $2), coalesce(tax_rate, $3) from kitchens where id = $1 and deleted_at is null`,
+		order.KitchenId, o.Fees.DeliveryFee, o.Fees.TaxRate).Scan(&deliveryFee, &taxRate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return pricing.Breakdown{}, "", ErrKitchenNotFound
+	}
 	if err != nil {
-		log.Error("error getting dishes", zap.Error(err))
-		return resp, err
+		return pricing.Breakdown{}, "", err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var order pb.Order
-
-		err = rows.Scan(&order.Id, &order.KitchenId, &order.TotalAmount, &order.Status, &order.DeliveryTime)
+	var discount *pricing.Discount
+	var code string
+	if order.DiscountCode != "" {
+		discount = &pricing.Discount{}
+		query := `select code, percent_off, amount_off, min_subtotal from discounts
+			where upper(code) = upper($1) and active
+			and (kitchen_id is null or kitchen_id = $2)
+			and (expires_at is null or expires_at > now())`
+
+		err = tx.QueryRowContext(ctx, query, order.DiscountCode, order.KitchenId).Scan(&code, &discount.PercentOff, &discount.AmountOff, &discount.MinSubtotal)
+		if errors.Is(err, sql.ErrNoRows) {
+			return pricing.Breakdown{}, "", ErrInvalidDiscount
+		}
 		if err != nil {
-			log.Error("error getting dishes", zap.Error(err))
-			return resp, err
+			return pricing.Breakdown{}, "", err
 		}
-		orders = append(orders, &order)
 	}
-	resp.Order = orders
-	log.Info("Get Order", zap.Any("Orders", orders))
+
+	lines := make([]pricing.Line, 0, len(order.Items))
+	for _, item := range order.Items {
+		lines = append(lines, pricing.Line{Price: item.Price, Quantity: item.Quantity})
+	}
+	breakdown, err := pricing.Quote(lines, deliveryFee, taxRate, discount)
+	return breakdown, code, err
+}
+
+// loadOrderItems attaches the stored line items to each order.
+func (o *OrderRepository) loadOrderItems(ctx context.Context, orders []*pb.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*pb.Order, len(orders))
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		byID[order.Id] = order
+		ids = append(ids, order.Id)
+	}
+
+	rows, err := o.DB.QueryContext(ctx, `select order_id, dish_id, name, price, quantity from order_items where order_id::text = any($1) order by order_id, name`, pq.Array(ids))
 	if err != nil {
-		panic(err)
+		return err
 	}
+	defer rows.Close()
 
-	return resp, nil
+	for rows.Next() {
+		var orderID string
+		item := &pb.OrderItem{}
+		if err := rows.Scan(&orderID, &item.DishId, &item.Name, &item.Price, &item.Quantity); err != nil {
+			return err
+		}
+		byID[orderID].Items = append(byID[orderID].Items, item)
+	}
+	return rows.Err()
 }
 
-func (o *OrderRepository) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
+func (o *OrderRepository) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
-	resp := &pb.CreateReviewResponse{}
 
-	query := "insert into reviews(id, order_id, user_id, kitchen_id,rating, comment, created_at) values ($1,$2,$3,$4,$5,$6,$7)"
-	_, err = o.DB.ExecContext(ctx, query)
+	page, err := o.startPage(pagetoken.Scope("orders", req.UserId, req.KitchenId, req.Status), req.PageToken, req.Page, req.Limit)
 	if err != nil {
-		log.Error("error inserting review", zap.Error(err))
+		return nil, err
+	}
+	resp := &pb.ListOrdersResponse{Page: page.number, Limit: req.Limit}
+
+	where := ` from orders where deleted_at is null
+		and ($1 = '' or user_id::text = $1)
+		and ($2 = '' or kitchen_id::text = $2)
+		and ($3 = '' or status = $3)`
+
+	if err = o.DB.QueryRowContext(ctx, `select count(*)`+where, req.UserId, req.KitchenId, req.Status).Scan(&resp.Total); err != nil {
+		log.Error("error counting orders", zap.Error(err))
+		return nil, err
+	}
+
+	query := `select ` + orderColumns + `, created_at` + where + `
+		and ($4::timestamptz is null or (created_at, id) < ($4, $5::uuid))
+		order by created_at desc, id desc
+		limit nullif($6, 0) offset $7`
+
+	after, afterID := page.after()
+	rows, err := o.DB.QueryContext(ctx, query, req.UserId, req.KitchenId, req.Status, after, afterID, page.fetch(), page.offset())
+	if err != nil {
+		log.Error("error getting orders", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var createdAt time.Time
+	for rows.Next() {
+		if page.full(len(resp.Orders)) {
+			resp.NextPageToken = page.next(o.Pages, pagetoken.Cursor{CreatedAt: createdAt, ID: resp.Orders[len(resp.Orders)-1].Id})
+			break
+		}
+		order := &pb.Order{}
+		if err = scanOrder(keyedRows{rows, []any{&createdAt}}, order); err != nil {
+			log.Error("error scanning order", zap.Error(err))
+			return nil, err
+		}
+		resp.Orders = append(resp.Orders, order)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err = o.loadOrderItems(ctx, resp.Orders); err != nil {
+		log.Error("error getting order items", zap.Error(err))
 		return nil, err
 	}
 	return resp, nil
 }
 
-func (o *OrderRepository) UpdateDishNutritionInfo(ctx context.Context, req *pb.UpdateDishNutritionInfoRequest) (*pb.UpdateDishNutritionInfoResponse, error) {
-
+func (o *OrderRepository) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
-	query := `UPDATE dishes SET allergens = $1, calories = $2, protein = $3, carbohydrates = $4, fat = $5, dietary_info = $6 WHERE id = $7`
-	_, err = o.DB.ExecContext(ctx, query, pq.Array(req.Allergens), req.Calories, req.Protein, req.Carbohydrates, req.Fat, pq.Array(req.DietaryInfo), req.DishId)
+
+	page, err := o.startPage(pagetoken.Scope("kitchen orders", req.KitchenID), req.PageToken, req.Page, req.Limit)
 	if err != nil {
-		log.Error("error updating dishes", zap.Error(err))
 		return nil, err
 	}
 
-	_, err = getDish(o.DB, req.DishId)
+	query := `select ` + orderColumns + `, created_at from orders
+		where kitchen_id = $1 and deleted_at is null
+		and ($2::timestamptz is null or (created_at, id) < ($2, $3::uuid))
+		order by created_at desc, id desc
+		limit nullif($4, 0) offset $5`
+
+	after, afterID := page.after()
+	rows, err := o.DB.QueryContext(ctx, query, req.KitchenID, after, afterID, page.fetch(), page.offset())
 	if err != nil {
-		log.Error("error getting dish", zap.Error(err))
+		log.Error("error getting orders", zap.Error(err))
 		return nil, err
 	}
-	if err != nil {
-		panic(err)
+	defer rows.Close()
+
+	resp := &pb.GetOrderResponse{}
+	var createdAt time.Time
+	for rows.Next() {
+		if page.full(len(resp.Order)) {
+			resp.NextPageToken = page.next(o.Pages, pagetoken.Cursor{CreatedAt: createdAt, ID: resp.Order[len(resp.Order)-1].Id})
+			break
+		}
+		order := &pb.Order{}
+		if err = scanOrder(keyedRows{rows, []any{&createdAt}}, order); err != nil {
+			log.Error("error scanning order", zap.Error(err))
+			return nil, err
+		}
+		resp.Order = append(resp.Order, order)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
 	}
 
-	return &pb.UpdateDishNutritionInfoResponse{}, nil
+	if err = o.loadOrderItems(ctx, resp.Order); err != nil {
+		log.Error("error getting order items", zap.Error(err))
+		return nil, err
+	}
+	if req.IncludeHistory {
+		if err = o.loadStatusHistory(ctx, resp.Order); err != nil {
+			log.Error("error getting order status history", zap.Error(err))
+			return nil, err
+		}
+	}
+	return resp, nil
 }
 
-func (o *OrderRepository) ListReviews(ctx context.Context, name string, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+var (
+	ErrInvalidRating    = apperr.Invalid("review.rating", "rating must be between 1 and 5")
+	ErrReviewExists     = apperr.New(apperr.Conflict, "order has already been reviewed")
+	ErrReviewNotAllowed = apperr.New(apperr.Forbidden, "only the customer who placed the order can review it")
+)
+
+// CreateReview records the customer's review of their order and updates the
+// kitchen's rating, the average of its reviews. An order can be reviewed once.
+func (o *OrderRepository) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
+	if req.Review.Rating < 1 || req.Review.Rating > 5 {
+		return nil, ErrInvalidRating
+	}
 
-	resp := &pb.ListReviewsResponse{}
-
-	query := "SELECT  " +
-		"reviews.id," +
-		"users.username," +
-		"reviews.rating," +
-		"reviews.comment, " +
-		"reviews.created_at" +
-		"FROM reviews JOIN  users ON reviews.user_id = users.id" +
-		"WHERE reviews.kitchen_id = $1'" +
-		"ORDER BY reviews.created_at DESC "
+	var userID, kitchenID string
+	err = o.DB.QueryRowContext(ctx, `select user_id, kitchen_id from orders where id::text = $1 and deleted_at is null`, req.Review.OrderId).Scan(&userID, &kitchenID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if userID != req.Review.UserId {
+		return nil, ErrReviewNotAllowed
+	}
 
-	var offset int32
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
-	if req.Page > 0 {
-		offset = (req.Page * req.Limit) - req.Limit
+	// Locking the kitchen makes concurrent reviews of it update its rating
+	// one after the other, each seeing the reviews before it.
+	if _, err = tx.ExecContext(ctx, `select 1 from kitchens where id = $1 for update`, kitchenID); err != nil {
+		return nil, err
 	}
-	query += " limit " + cast.ToString(req.Limit) + "offset " + cast.ToString(offset)
 
-	var reviews []*pb.Review
+	query := `insert into reviews (id, order_id, user_id, kitchen_id, rating, comment)
+		values ($1, $2, $3, $4, $5, nullif($6, ''))
+		on conflict (order_id) do nothing
+		returning ` + reviewColumns
 
-	rows, err := o.DB.QueryContext(ctx, query, req.KitchenId, req.Limit, req.Page)
+	review := &pb.Review{}
+	err = scanReview(tx.QueryRowContext(ctx, query, uuid.NewString(), req.Review.OrderId, userID, kitchenID, req.Review.Rating, req.Review.Comment), review)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReviewExists
+	}
 	if err != nil {
-		log.Error("error getting reviews", zap.Error(err))
-		return resp, err
+		log.Error("error inserting review", zap.Error(err))
+		return nil, err
 	}
-	defer rows.Close()
 
-	for rows.Next() {
-		var review pb.Review
+	_, err = tx.ExecContext(ctx, `update kitchens set rating = (select round(avg(rating), 2) from reviews where kitchen_id = $1) where id = $1`, kitchenID)
+	if err != nil {
+		log.Error("error updating kitchen rating", zap.Error(err))
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
 
-		err = rows.Scan(&review.Id, &review.KitchenId, &review.OrderId, &review.UserId, &review.Rating, &review.Comment, &review.CreatedAt)
-		if err != nil {
-			log.Error("error getting reviews", zap.Error(err))
-			return resp, err
-		}
+	log.Info("insert review", zap.String("review_id", review.Id), zap.String("order_id", review.OrderId))
+	return &pb.CreateReviewResponse{Review: review}, nil
+}
 
-		reviews = append(reviews, &review)
+const reviewColumns = `id, order_id, user_id, kitchen_id, rating, coalesce(comment, ''), created_at`
 
+func scanReview(row interface{ Scan(...any) error }, review *pb.Review) error {
+	var createdAt time.Time
+	if err := row.Scan(&review.Id, &review.OrderId, &review.UserId, &review.KitchenId, &review.Rating, &review.Comment, &createdAt); err != nil {
+		return err
 	}
-
-	log.Info("List Reviews", zap.Any("Reviews", reviews))
-	return resp, nil
+	review.CreatedAt = createdAt.Format(time.RFC3339)
+	return nil
 }
 
-func (o *OrderRepository) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+func (o *OrderRepository) UpdateDishNutritionInfo(ctx context.Context, req *pb.UpdateDishNutritionInfoRequest) (*pb.UpdateDishNutritionInfoResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	if req.Payment == nil {
-		return nil, errors.New("invalid payment request")
+	query := `update dishes set allergens = $1, calories = $2, protein = $3, carbohydrates = $4, fat = $5, dietary_info = $6, updated_at = now()
+		where id::text = $7 and deleted_at is null
+		returning ` + dishColumns
+
+	dish := &pb.Dish{}
+	row := o.DB.QueryRowContext(ctx, query, pq.Array(nonNil(req.Allergens)), req.Calories, req.Protein, req.Carbohydrates, req.Fat, pq.Array(nonNil(req.DietaryInfo)), req.DishId)
+	err = scanDish(row, dish)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDishNotFound
 	}
-	if req.Payment.OrderId == "" || req.Payment.PaymentMethod == "" {
-		return nil, errors.New("order_id and payment_method are required")
+	if err != nil {
+		log.Error("error updating dish nutrition", zap.Error(err))
+		return nil, err
 	}
+	return &pb.UpdateDishNutritionInfoResponse{Dish: dish}, nil
+}
 
-	txID, err := ProcessPayment(req.Payment.OrderId, req.Payment.PaymentMethod, req.Payment.CardNumber, "12/24", "123", req.Payment.Amount)
+func (o *OrderRepository) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	paymentID := uuid.New().String()
-	payment := &pb.Payment{
-		Id:            paymentID,
-		OrderId:       req.Payment.OrderId,
-		Amount:        req.Payment.Amount,
-		Status:        "success",
-		PaymentMethod: req.Payment.PaymentMethod,
-		TransactionId: txID,
-		CreatedAt:     time.Now().Format(time.RFC3339),
+	page, err := o.startPage(pagetoken.Scope("reviews", req.KitchenId), req.PageToken, req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListReviewsResponse{Page: page.number, Limit: req.Limit}
+
+	err = o.DB.QueryRowContext(ctx, `select count(*), coalesce(avg(rating), 0) from reviews where kitchen_id::text = $1`, req.KitchenId).Scan(&resp.Total, &resp.AverageRating)
+	if err != nil {
+		log.Error("error counting reviews", zap.Error(err))
+		return nil, err
 	}
 
-	req.Payment = payment
+	query := `select ` + reviewColumns + `, created_at from reviews
+		where kitchen_id::text = $1
+		and ($2::timestamptz is null or (created_at, id) < ($2, $3::uuid))
+		order by created_at desc, id desc
+		limit nullif($4, 0) offset $5`
 
-	resp := &pb.CreatePaymentResponse{
-		Payment: payment,
+	after, afterID := page.after()
+	rows, err := o.DB.QueryContext(ctx, query, req.KitchenId, after, afterID, page.fetch(), page.offset())
+	if err != nil {
+		log.Error("error getting reviews", zap.Error(err))
+		return nil, err
 	}
+	defer rows.Close()
 
-	log.Info("Payment created successfully:")
-	return resp, nil
+	var createdAt time.Time
+	for rows.Next() {
+		if page.full(len(resp.Reviews)) {
+			resp.NextPageToken = page.next(o.Pages, pagetoken.Cursor{CreatedAt: createdAt, ID: resp.Reviews[len(resp.Reviews)-1].Id})
+			break
+		}
+		review := &pb.Review{}
+		if err = scanReview(keyedRows{rows, []any{&createdAt}}, review); err != nil {
+			log.Error("error scanning review", zap.Error(err))
+			return nil, err
+		}
+		resp.Reviews = append(resp.Reviews, review)
+	}
+	return resp, rows.Err()
 }
 
+// recommendationLimit caps GetDishRecommendations.
+const recommendationLimit = 10
+
+// GetDishRecommendations suggests available dishes the user has not ordered
+// yet, the most ordered first.
 func (o *OrderRepository) GetDishRecommendations(ctx context.Context, req *pb.GetDishRecommendationsRequest) (*pb.GetDishRecommendationsResponse, error) {
 	log, err := l.NewLogger()
 	if err != nil {
 		return nil, err
 	}
 
-	query := `SELECT id, name, description, price FROM dishes 
-               WHERE user_id = $1 
-               ORDER BY rating DESC`
-
-	rows, err := o.DB.QueryContext(ctx, query, req.UserId)
+	query := `select ` + dishColumns + ` from (
+			select d.*, coalesce((select sum(quantity) from order_items where dish_id = d.id), 0) as ordered
+			from dishes d
+			where d.deleted_at is null and d.available
+			and not exists (
+				select 1 from order_items oi join orders o on o.id = oi.order_id
+				where oi.dish_id = d.id and o.user_id::text = $1
+			)
+		) d
+		order by ordered desc, name, id
+		limit $2`
+
+	rows, err := o.DB.QueryContext(ctx, query, req.UserId, recommendationLimit)
 	if err != nil {
 		log.Error("error getting dish recommendations", zap.Error(err))
 		return nil, err
 	}
 	defer rows.Close()
 
-	var dishes []*pb.Dish
+	resp := &pb.GetDishRecommendationsResponse{}
 	for rows.Next() {
 		dish := &pb.Dish{}
-		err := rows.Scan(&dish.Id, &dish.Name, &dish.Description, &dish.Price)
-		if err != nil {
+		if err = scanDish(rows, dish); err != nil {
 			log.Error("error scanning dish", zap.Error(err))
 			return nil, err
 		}
-		dishes = append(dishes, dish)
+		resp.Recommendations = append(resp.Recommendations, dish)
 	}
-
-	log.Info("Dish recommendations retrieved successfully", zap.Int("count", len(dishes)))
-	return &pb.GetDishRecommendationsResponse{Recommendations: dishes}, nil
+	resp.Total = int32(len(resp.Recommendations))
+	return resp, rows.Err()
 }
 
 func (o *OrderRepository) GetKitchenStatistics(ctx context.Context, req *pb.GetKitchenStatisticsRequest) (*pb.GetKitchenStatisticsResponse, error) {
@@ -453,20 +1083,32 @@ func (o *OrderRepository) GetKitchenStatistics(ctx context.Context, req *pb.GetK
 		return nil, err
 	}
 
-	query := `SELECT COUNT(*) as total_orders, AVG(total_amount) as avg_order_value, 
-               SUM(total_amount) as total_revenue 
-               FROM orders 
-               WHERE kitchen_id = $1 AND created_at >= $2 AND created_at <= $3`
+	// Revenue is the money taken for the kitchen's orders: captured payments,
+	// and cash-on-delivery ones once the order is delivered. Refunds of those
+	// payments, including those of cancelled orders, are taken off again.
+	// Empty date bounds are open.
+	query := `select
+			count(distinct o.id),
+			coalesce(sum(p.amount), 0),
+			coalesce(sum(p.refunded_amount), 0),
+			coalesce((select avg(rating) from reviews where kitchen_id = $1 and created_at between b.start_at and b.end_at), 0)
+		from (select coalesce(nullif($2, '')::timestamptz, '-infinity') as start_at, coalesce(nullif($3, '')::timestamptz, 'infinity') as end_at) b
+		left join orders o on o.kitchen_id = $1 and o.deleted_at is null and o.created_at between b.start_at and b.end_at
+		left join payments p on p.order_id = o.id
+			and (p.status in ('captured', 'partially_refunded', 'refunded')
+				or (p.status = 'authorized' and p.provider = $4 and o.status = 'delivered'))
+		group by b.start_at, b.end_at`
 
 	var stats pb.GetKitchenStatisticsResponse
-	err = o.DB.QueryRowContext(ctx, query, req.KitchenId, req.StartDate, req.EndDate).Scan(
-		&stats.TotalOrders, &stats.AverageRating, &stats.TotalRevenue)
+	err = o.DB.QueryRowContext(ctx, query, req.KitchenId, req.StartDate, req.EndDate, payment.ProviderCashOnDelivery).Scan(
+		&stats.TotalOrders, &stats.GrossRevenue, &stats.RefundedAmount, &stats.AverageRating)
 	if err != nil {
 		log.Error("error getting kitchen statistics", zap.Error(err))
 		return nil, err
 	}
+	stats.TotalRevenue = pricing.Round(stats.GrossRevenue - stats.RefundedAmount)
 
-	log.Info("Kitchen statistics retrieved successfully", zap.Any("stats", stats))
+	log.Info("Kitchen statistics retrieved successfully", zap.Any("stats", &stats))
 	return &stats, nil
 }
 
@@ -476,11 +1118,11 @@ func (o *OrderRepository) GetUserActivity(ctx context.Context, req *pb.GetUserAc
 		return nil, err
 	}
 
-	query := `SELECT o.id, o.total_amount, o.status, o.created_at, k.name as kitchen_name 
-               FROM orders o 
-               JOIN kitchens k ON o.kitchen_id = k.id 
-               WHERE o.user_id = $1 
-               ORDER BY o.created_at DESC`
+	query := `select o.id, o.total_amount, o.status, o.created_at, coalesce(k.name, '')
+		from orders o
+		left join kitchens k on k.id = o.kitchen_id
+		where o.user_id::text = $1 and o.deleted_at is null
+		order by o.created_at desc, o.id`
 
 	rows, err := o.DB.QueryContext(ctx, query, req.UserId)
 	if err != nil {
@@ -489,19 +1131,25 @@ func (o *OrderRepository) GetUserActivity(ctx context.Context, req *pb.GetUserAc
 	}
 	defer rows.Close()
 
-	var activities []*pb.UserActivity
+	resp := &pb.GetUserActivityResponse{}
 	for rows.Next() {
+		var createdAt time.Time
 		activity := &pb.UserActivity{}
-		err := rows.Scan(&activity.OrderId, &activity.Amount, &activity.Status, &activity.CreatedAt, &activity.KitchenName)
-		if err != nil {
+		if err = rows.Scan(&activity.OrderId, &activity.Amount, &activity.Status, &createdAt, &activity.KitchenName); err != nil {
 			log.Error("error scanning user activity", zap.Error(err))
 			return nil, err
 		}
-		activities = append(activities, activity)
+		activity.CreatedAt = createdAt.Format(time.RFC3339)
+		resp.UserActivity = append(resp.UserActivity, activity)
 	}
+	return resp, rows.Err()
+}
 
-	log.Info("User activity retrieved successfully", zap.Int("count", len(activities)))
-	return &pb.GetUserActivityResponse{UserActivity: activities}, nil
+// workingHours is the JSON layout of kitchens.working_hours.
+type workingHours struct {
+	DayOfWeek int32  `json:"day_of_week"`
+	OpenTime  string `json:"open_time"`
+	CloseTime string `json:"close_time"`
 }
 
 func (o *OrderRepository) UpdateWorkingHours(ctx context.Context, req *pb.UpdateWorkingHoursRequest) (*pb.UpdateWorkingHoursResponse, error) {
@@ -510,40 +1158,37 @@ func (o *OrderRepository) UpdateWorkingHours(ctx context.Context, req *pb.Update
 		return nil, err
 	}
 
-	query := `UPDATE kitchens SET working_hours = $1 WHERE id = $2`
-	_, err = o.DB.ExecContext(ctx, query, pq.Array(req.WorkingHours), req.KitchenId)
+	hours := make([]workingHours, 0, len(req.WorkingHours))
+	for _, h := range req.WorkingHours {
+		hours = append(hours, workingHours{DayOfWeek: h.DayOfWeek, OpenTime: h.OpenTime, CloseTime: h.CloseTime})
+	}
+	body, err := json.Marshal(hours)
 	if err != nil {
-		log.Error("error updating working hours", zap.Error(err))
 		return nil, err
 	}
 
-	kitchen, err := getDish(o.DB, req.KitchenId)
+	var updatedAt time.Time
+	err = o.DB.QueryRowContext(ctx, `update kitchens set working_hours = $1, updated_at = now() where id::text = $2 and deleted_at is null returning updated_at`, string(body), req.KitchenId).Scan(&updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrKitchenNotFound
+	}
 	if err != nil {
-		log.Error("error getting kitchen", zap.Error(err))
+		log.Error("error updating working hours", zap.Error(err))
 		return nil, err
 	}
 
-	log.Info("Working hours updated successfully", zap.Any("kitchen", kitchen))
-	return &pb.UpdateWorkingHoursResponse{WorkingHours: nil}, nil
+	log.Info("Working hours updated successfully", zap.String("kitchen_id", req.KitchenId))
+	return &pb.UpdateWorkingHoursResponse{KitchenId: req.KitchenId, WorkingHours: req.WorkingHours, UpdatedAt: updatedAt.Format(time.RFC3339)}, nil
 }
 
-func getDish(db *sql.DB, id string) (*pb.Dish, error) {
-	var dish pb.Dish
-	var ingredients, allergens, dietaryInfo []string
-	err := db.QueryRow(`SELECT id, kitchen_id, name, description, price, category, ingredients, allergens, nutrition_info, dietary_info, available, created_at, updated_at FROM dishes WHERE id = $1`, id).
-		Scan(&dish.Id, &dish.KitchenId, &dish.Name, &dish.Description, &dish.Price, &dish.Category, pq.Array(&ingredients), pq.Array(&allergens), &dish.NutritionInfo, pq.Array(&dietaryInfo), &dish.Available, &dish.CreatedAt, &dish.UpdatedAt)
-	if err != nil {
-		return nil, err
-	}
-	dish.Ingredients = ingredients
-	dish.Allergens = allergens
-	dish.DietaryInfo = dietaryInfo
-	return &dish, nil
+func (o *OrderRepository) KitchenOwner(ctx context.Context, kitchenID string) (string, error) {
+	var ownerID string
+	err := o.DB.QueryRowContext(ctx, `select owner_id from kitchens where id = $1`, kitchenID).Scan(&ownerID)
+	return ownerID, err
 }
 
-func ProcessPayment(orderID, paymentMethod, cardNumber, expiryDate, cvv string, amount float64) (string, error) {
-	if cardNumber == "4111111111111111" && cvv == "123" {
-		return "tx789", nil
-	}
-	return "", errors.New("payment failed")
+func (o *OrderRepository) DishKitchen(ctx context.Context, dishID string) (string, error) {
+	var kitchenID string
+	err := o.DB.QueryRowContext(ctx, `select kitchen_id from dishes where id = $1 and deleted_at is null`, dishID).Scan(&kitchenID)
+	return kitchenID, err
 }
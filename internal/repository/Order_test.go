@@ -0,0 +1,537 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+)
+
+func createDish(t *testing.T, repo *OrderRepository, kitchenID, name string, price float64) *pb.Dish {
+	t.Helper()
+	resp, err := repo.CreateDish(context.Background(), &pb.CreateDishRequest{Dish: &pb.Dish{
+		KitchenId:   kitchenID,
+		Name:        name,
+		Price:       price,
+		Category:    "main",
+		Ingredients: []string{"water", "salt"},
+		Available:   true,
+	}})
+	if err != nil {
+		t.Fatalf("CreateDish: %v", err)
+	}
+	return resp.Dish
+}
+
+func createOrder(t *testing.T, repo *OrderRepository, userID, kitchenID string, items ...*pb.OrderItem) *pb.Order {
+	t.Helper()
+	resp, err := repo.CreateOrder(context.Background(), &pb.CreateOrderRequest{Order: &pb.Order{UserId: userID, KitchenId: kitchenID, Items: items}})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	return resp.Order
+}
+
+// payOrder captures a card payment covering the order's total.
+func payOrder(t *testing.T, repo *OrderRepository, fake *payment.Fake, order *pb.Order) *pb.Payment {
+	t.Helper()
+	card, err := fake.Tokenize(context.Background(), "4242424242424242")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := repo.CreatePayment(context.Background(), &pb.CreatePaymentRequest{Payment: &pb.Payment{
+		OrderId:       order.Id,
+		Amount:        order.TotalAmount,
+		PaymentMethod: "card",
+		CardToken:     card.Token,
+		CardLast4:     card.Last4,
+		CardBrand:     card.Brand,
+	}})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	return resp.Payment
+}
+
+func TestDishes(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+
+	soup := createDish(t, repo, kitchenID, "Soup", 4.5)
+	if soup.Id == "" || soup.KitchenId != kitchenID || soup.Price != 4.5 || len(soup.Ingredients) != 2 || soup.CreatedAt == "" {
+		t.Fatalf("created dish %v", soup)
+	}
+	bread := createDish(t, repo, kitchenID, "Bread", 1.5)
+	createDish(t, repo, kitchenID, "Pie", 3)
+
+	if _, err := repo.CreateDish(ctx, &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: "00000000-0000-0000-0000-000000000000", Name: "Ghost"}}); !errors.Is(err, ErrKitchenNotFound) {
+		t.Fatalf("CreateDish in a missing kitchen: %v", err)
+	}
+
+	updated, err := repo.UpdateDish(ctx, &pb.UpdateDishRequest{DishId: soup.Id, Dish: &pb.Dish{Id: soup.Id, Name: "Tomato soup", Price: 5, Available: false}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Dish.Name != "Tomato soup" || updated.Dish.Price != 5 || updated.Dish.Available || updated.Dish.Category != "main" {
+		t.Fatalf("updated dish %v", updated.Dish)
+	}
+
+	nutrition, err := repo.UpdateDishNutritionInfo(ctx, &pb.UpdateDishNutritionInfoRequest{DishId: bread.Id, Allergens: []string{"gluten"}, Calories: 250, DietaryInfo: []string{"vegan"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nutrition.Dish.Allergens) != 1 || nutrition.Dish.Allergens[0] != "gluten" || len(nutrition.Dish.DietaryInfo) != 1 {
+		t.Fatalf("nutrition update returned %v", nutrition.Dish)
+	}
+
+	page, err := repo.GetDishes(ctx, &pb.ListDishesRequest{KitchenId: kitchenID, Page: 2, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 3 || len(page.Dishes) != 1 || page.Dishes[0].Name != "Pie" {
+		t.Fatalf("page 2 has %d of %d dishes: %v", len(page.Dishes), page.Total, page.Dishes)
+	}
+
+	first, err := repo.GetDishes(ctx, &pb.ListDishesRequest{KitchenId: kitchenID, Page: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Dishes) != 2 || first.NextPageToken == "" {
+		t.Fatalf("page 1 = %v, next %q", first.Dishes, first.NextPageToken)
+	}
+	next, err := repo.GetDishes(ctx, &pb.ListDishesRequest{KitchenId: kitchenID, Limit: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.Page != 2 || len(next.Dishes) != 1 || next.Dishes[0].Name != "Pie" || next.NextPageToken != "" {
+		t.Fatalf("page after token %d = %v, next %q", next.Page, next.Dishes, next.NextPageToken)
+	}
+
+	owner, err := repo.KitchenOwner(ctx, kitchenID)
+	if err != nil || owner != chef {
+		t.Fatalf("KitchenOwner = %q, %v", owner, err)
+	}
+	dishKitchen, err := repo.DishKitchen(ctx, bread.Id)
+	if err != nil || dishKitchen != kitchenID {
+		t.Fatalf("DishKitchen = %q, %v", dishKitchen, err)
+	}
+
+	if _, err := repo.DeleteDish(ctx, &pb.DeleteDishRequest{DishId: bread.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.DeleteDish(ctx, &pb.DeleteDishRequest{DishId: bread.Id}); !errors.Is(err, ErrDishNotFound) {
+		t.Fatalf("deleting twice: %v", err)
+	}
+	if _, err := repo.DishKitchen(ctx, bread.Id); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("DishKitchen of a deleted dish: %v", err)
+	}
+	if _, err := repo.UpdateDish(ctx, &pb.UpdateDishRequest{Dish: &pb.Dish{Id: bread.Id, Name: "Bread"}}); !errors.Is(err, ErrDishNotFound) {
+		t.Fatalf("updating a deleted dish: %v", err)
+	}
+
+	all, err := repo.GetDishes(ctx, &pb.ListDishesRequest{KitchenId: kitchenID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.Total != 2 || len(all.Dishes) != 2 {
+		t.Fatalf("listing without a limit returned %d of %d dishes", len(all.Dishes), all.Total)
+	}
+}
+
+func TestCreateOrder(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+	otherKitchen := seedKitchen(t, db, seedUser(t, db, "chef"))
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	elsewhere := createDish(t, repo, otherKitchen, "Elsewhere", 1)
+
+	if _, err := db.Exec(`insert into discounts (code, kitchen_id, percent_off) values ('SAVE10', $1, 10)`, kitchenID); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := repo.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId:          customer,
+		KitchenId:       kitchenID,
+		DeliveryAddress: "1 Main St",
+		DiscountCode:    "save10",
+		Items:           []*pb.OrderItem{{DishId: soup.Id, Quantity: 2, Price: 0.01, Name: "ignored"}},
+		TotalAmount:     21.8,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := resp.Order
+	want := &pb.PriceBreakdown{Subtotal: 20, Discount: 2, Tax: 1.8, DeliveryFee: 2, Total: 21.8}
+	got := order.PriceBreakdown
+	if got.Subtotal != want.Subtotal || got.Discount != want.Discount || got.Tax != want.Tax || got.DeliveryFee != want.DeliveryFee || got.Total != want.Total {
+		t.Fatalf("breakdown = %v, want %v", got, want)
+	}
+	if order.Status != orderstatus.Pending || order.DiscountCode != "SAVE10" || order.Items[0].Price != 10 || order.Items[0].Name != "Soup" {
+		t.Fatalf("created order %v", order)
+	}
+
+	stored, err := repo.GetOrderByID(ctx, order.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored.Items) != 1 || stored.Items[0].Quantity != 2 || stored.DeliveryAddress != "1 Main St" {
+		t.Fatalf("stored order %v", stored)
+	}
+	if _, err := repo.GetOrderByID(ctx, "00000000-0000-0000-0000-000000000000"); !errors.Is(err, ErrOrderNotFound) {
+		t.Fatalf("GetOrderByID of a missing order: %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		order *pb.Order
+		err   error
+	}{
+		"no items":      {&pb.Order{KitchenId: kitchenID}, ErrNoOrderItems},
+		"bad quantity":  {&pb.Order{KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: soup.Id}}}, ErrInvalidQuantity},
+		"wrong kitchen": {&pb.Order{KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: elsewhere.Id, Quantity: 1}}}, ErrDishWrongKitchen},
+		"bad discount":  {&pb.Order{KitchenId: kitchenID, DiscountCode: "NOPE", Items: []*pb.OrderItem{{DishId: soup.Id, Quantity: 1}}}, ErrInvalidDiscount},
+		"wrong total":   {&pb.Order{KitchenId: kitchenID, TotalAmount: 1, Items: []*pb.OrderItem{{DishId: soup.Id, Quantity: 1}}}, ErrTotalMismatch},
+		"missing dish":  {&pb.Order{KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: "00000000-0000-0000-0000-000000000000", Quantity: 1}}}, ErrDishNotFound},
+	} {
+		tc.order.UserId = customer
+		if _, err := repo.CreateOrder(ctx, &pb.CreateOrderRequest{Order: tc.order}); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %v, want %v", name, err, tc.err)
+		}
+	}
+
+	if _, err := db.Exec(`update discounts set min_subtotal = 100`); err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{UserId: customer, KitchenId: kitchenID, DiscountCode: "SAVE10", Items: []*pb.OrderItem{{DishId: soup.Id, Quantity: 1}}}})
+	if !errors.Is(err, pricing.ErrDiscountMinimum) {
+		t.Fatalf("discount below its minimum: %v", err)
+	}
+}
+
+func TestListOrders(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	alice := seedUser(t, db, "customer")
+	bob := seedUser(t, db, "customer")
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+	soup := createDish(t, repo, kitchenID, "Soup", 5)
+
+	var aliceOrders []*pb.Order
+	for i := 0; i < 3; i++ {
+		aliceOrders = append(aliceOrders, createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1}))
+	}
+	createOrder(t, repo, bob, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+
+	if _, err := repo.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: aliceOrders[0].Id, Status: orderstatus.Rejected, Reason: "closed"}, chef); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := repo.ListOrders(ctx, &pb.ListOrdersRequest{UserId: alice, Status: orderstatus.Pending, Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 2 || len(list.Orders) != 2 || list.Orders[0].Id != aliceOrders[2].Id || len(list.Orders[0].Items) != 1 {
+		t.Fatalf("ListOrders returned %d of %d orders", len(list.Orders), list.Total)
+	}
+
+	page, err := repo.GetOrder(ctx, &pb.GetOrderRequest{KitchenID: kitchenID, Page: 2, Limit: 3, IncludeHistory: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Order) != 1 || page.Order[0].Id != aliceOrders[0].Id || page.NextPageToken != "" {
+		t.Fatalf("GetOrder page 2 = %v", page.Order)
+	}
+	// Orders placed while a client pages through a listing do not shift the
+	// pages after a token.
+	first, err := repo.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Page: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	createOrder(t, repo, bob, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	second, err := repo.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Limit: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Page != 2 || second.Total != 5 || len(second.Orders) != 2 || second.Orders[0].Id != aliceOrders[1].Id || second.Orders[1].Id != aliceOrders[0].Id {
+		t.Fatalf("page after token %d = %v", second.Page, second.Orders)
+	}
+	if _, err := repo.ListReviews(ctx, &pb.ListReviewsRequest{KitchenId: kitchenID, PageToken: first.NextPageToken}); !errors.Is(err, pagetoken.ErrInvalid) {
+		t.Fatalf("orders token used for reviews: %v", err)
+	}
+
+	history := page.Order[0].StatusHistory
+	if len(history) != 2 || history[1].FromStatus != orderstatus.Pending || history[1].ToStatus != orderstatus.Rejected || history[1].Reason != "closed" || history[1].ActorId != chef {
+		t.Fatalf("status history = %v", history)
+	}
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	db := newTestDB(t)
+	repo, fake := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	order := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 2})
+
+	accept := &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Accepted}
+	if _, err := repo.UpdateOrderStatus(ctx, accept, chef); !errors.Is(err, ErrPaymentRequired) {
+		t.Fatalf("accepting an unpaid order: %v", err)
+	}
+	paid := payOrder(t, repo, fake, order)
+	if _, err := repo.UpdateOrderStatus(ctx, accept, chef); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Delivered}, chef); !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("skipping ahead: %v", err)
+	}
+	if _, err := repo.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Preparing}, chef); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Cancelled, Reason: "x"}, chef); !errors.Is(err, ErrCancelViaStatus) {
+		t.Fatalf("cancelling through UpdateOrderStatus: %v", err)
+	}
+
+	if _, err := repo.CancelOrder(ctx, order.Id, customer, "", []string{orderstatus.Pending, orderstatus.Accepted}); !errors.Is(err, ErrCancellationWindow) {
+		t.Fatalf("customer cancelling while preparing: %v", err)
+	}
+	cancelled, err := repo.CancelOrder(ctx, order.Id, chef, "out of soup", []string{orderstatus.Pending, orderstatus.Accepted, orderstatus.Preparing, orderstatus.Ready})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.Order.Status != orderstatus.Cancelled || len(cancelled.Refunds) != 1 || cancelled.Refunds[0].Id != paid.Id || cancelled.Refunds[0].Status != payment.StatusRefunded {
+		t.Fatalf("CancelOrder returned %v", cancelled)
+	}
+	// The refund leaves the whole total unpaid, but a cancelled order is not
+	// charged again.
+	if _, err := repo.CreatePayment(ctx, &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card"}}); !errors.Is(err, ErrOrderNotPayable) {
+		t.Fatalf("paying a cancelled order: %v", err)
+	}
+
+	second := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	payOrder(t, repo, fake, second)
+	unpaid := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 3})
+
+	// Only money taken counts: the cancelled order's payment is refunded in
+	// full and the unpaid order adds nothing.
+	stats, err := repo.GetKitchenStatistics(ctx, &pb.GetKitchenStatisticsRequest{KitchenId: kitchenID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.TotalOrders != 3 || !pricing.Equal(stats.GrossRevenue, order.TotalAmount+second.TotalAmount) ||
+		!pricing.Equal(stats.RefundedAmount, order.TotalAmount) || !pricing.Equal(stats.TotalRevenue, second.TotalAmount) {
+		t.Fatalf("statistics = %v", stats)
+	}
+	empty, err := repo.GetKitchenStatistics(ctx, &pb.GetKitchenStatisticsRequest{KitchenId: kitchenID, StartDate: "2000-01-01", EndDate: "2000-12-31"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.TotalOrders != 0 || empty.TotalRevenue != 0 {
+		t.Fatalf("statistics outside the range = %v", empty)
+	}
+
+	activity, err := repo.GetUserActivity(ctx, &pb.GetUserActivityRequest{UserId: customer})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(activity.UserActivity) != 3 || activity.UserActivity[0].OrderId != unpaid.Id || activity.UserActivity[1].OrderId != second.Id || activity.UserActivity[2].Status != orderstatus.Cancelled || activity.UserActivity[0].KitchenName != "Mama's" {
+		t.Fatalf("activity = %v", activity.UserActivity)
+	}
+}
+
+func TestDishFiltersAndSorts(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	alice := seedUser(t, db, "customer")
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+	otherKitchen := seedKitchen(t, db, seedUser(t, db, "chef"))
+
+	soup := createDish(t, repo, kitchenID, "Soup", 4)
+	bread := createDish(t, repo, kitchenID, "Bread", 2)
+	createDish(t, repo, otherKitchen, "Curry", 8)
+	for _, nutrition := range []*pb.UpdateDishNutritionInfoRequest{
+		{DishId: soup.Id, Allergens: []string{"Celery"}, DietaryInfo: []string{"Vegan", "gluten-free"}},
+		{DishId: bread.Id, Allergens: []string{"gluten"}, DietaryInfo: []string{"vegan"}},
+	} {
+		if _, err := repo.UpdateDishNutritionInfo(ctx, nutrition); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, review := range []struct {
+		dish   string
+		rating float32
+	}{{soup.Id, 3}, {bread.Id, 5}} {
+		order := createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: review.dish, Quantity: 1})
+		if _, err := repo.CreateReview(ctx, &pb.CreateReviewRequest{Review: &pb.Review{OrderId: order.Id, UserId: alice, Rating: review.rating}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 2})
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListDishesRequest
+		want string
+	}{
+		{"whole catalogue", &pb.ListDishesRequest{}, "Soup Bread Curry"},
+		{"excluded allergens ignore case", &pb.ListDishesRequest{ExcludeAllergens: []string{" CELERY "}}, "Bread Curry"},
+		{"dietary tags must all match", &pb.ListDishesRequest{DietaryInfo: []string{"vegan", "Gluten-Free"}}, "Soup"},
+		{"kitchen and price", &pb.ListDishesRequest{KitchenId: kitchenID, MinPrice: 3, Category: "MAIN"}, "Soup"},
+		{"price", &pb.ListDishesRequest{SortBy: DishSortPriceAsc}, "Bread Soup Curry"},
+		{"rating", &pb.ListDishesRequest{SortBy: DishSortRating}, "Bread Soup Curry"},
+		{"popularity", &pb.ListDishesRequest{SortBy: DishSortPopularity}, "Soup Bread Curry"},
+		{"newest", &pb.ListDishesRequest{SortBy: DishSortNewest}, "Curry Bread Soup"},
+	} {
+		resp, err := repo.GetDishes(ctx, tc.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		var names []string
+		for _, dish := range resp.Dishes {
+			names = append(names, dish.Name)
+		}
+		if got := strings.Join(names, " "); got != tc.want || int(resp.Total) != len(names) {
+			t.Errorf("%s: got %s of %d, want %s", tc.name, got, resp.Total, tc.want)
+		}
+	}
+
+	first, err := repo.GetDishes(ctx, &pb.ListDishesRequest{SortBy: DishSortPriceDesc, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := repo.GetDishes(ctx, &pb.ListDishesRequest{SortBy: DishSortPriceDesc, Limit: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(next.Dishes) != 1 || next.Dishes[0].Id != bread.Id || next.NextPageToken != "" {
+		t.Fatalf("second page by price = %v", next.Dishes)
+	}
+	if _, err := repo.GetDishes(ctx, &pb.ListDishesRequest{SortBy: DishSortPriceAsc, Limit: 2, PageToken: first.NextPageToken}); !errors.Is(err, pagetoken.ErrInvalid) {
+		t.Fatalf("token reused with another sort: %v", err)
+	}
+}
+
+func TestReviews(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	alice := seedUser(t, db, "customer")
+	bob := seedUser(t, db, "customer")
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+	soup := createDish(t, repo, kitchenID, "Soup", 5)
+	first := createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	second := createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+
+	review, err := repo.CreateReview(ctx, &pb.CreateReviewRequest{Review: &pb.Review{OrderId: first.Id, UserId: alice, Rating: 4, Comment: "good"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if review.Review.KitchenId != kitchenID || review.Review.Rating != 4 || review.Review.Comment != "good" {
+		t.Fatalf("created review %v", review.Review)
+	}
+	if _, err := repo.CreateReview(ctx, &pb.CreateReviewRequest{Review: &pb.Review{OrderId: second.Id, UserId: alice, Rating: 5}}); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		review *pb.Review
+		err    error
+	}{
+		"twice":         {&pb.Review{OrderId: first.Id, UserId: alice, Rating: 3}, ErrReviewExists},
+		"not the buyer": {&pb.Review{OrderId: first.Id, UserId: bob, Rating: 3}, ErrReviewNotAllowed},
+		"bad rating":    {&pb.Review{OrderId: first.Id, UserId: alice, Rating: 6}, ErrInvalidRating},
+		"no order":      {&pb.Review{OrderId: "00000000-0000-0000-0000-000000000000", UserId: alice, Rating: 3}, ErrOrderNotFound},
+	} {
+		if _, err := repo.CreateReview(ctx, &pb.CreateReviewRequest{Review: tc.review}); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %v, want %v", name, err, tc.err)
+		}
+	}
+
+	list, err := repo.ListReviews(ctx, &pb.ListReviewsRequest{KitchenId: kitchenID, Page: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 2 || list.AverageRating != 4.5 || len(list.Reviews) != 1 || list.Reviews[0].OrderId != second.Id {
+		t.Fatalf("ListReviews = %v", list)
+	}
+}
+
+func TestDishRecommendations(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+
+	alice := seedUser(t, db, "customer")
+	bob := seedUser(t, db, "customer")
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+	soup := createDish(t, repo, kitchenID, "Soup", 5)
+	pie := createDish(t, repo, kitchenID, "Pie", 5)
+	bread := createDish(t, repo, kitchenID, "Bread", 2)
+	hidden := createDish(t, repo, kitchenID, "Hidden", 2)
+	if _, err := repo.UpdateDish(ctx, &pb.UpdateDishRequest{Dish: &pb.Dish{Id: hidden.Id, Name: "Hidden", Price: 2, Available: false}}); err != nil {
+		t.Fatal(err)
+	}
+
+	createOrder(t, repo, alice, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	createOrder(t, repo, bob, kitchenID, &pb.OrderItem{DishId: pie.Id, Quantity: 3})
+
+	recs, err := repo.GetDishRecommendations(ctx, &pb.GetDishRecommendationsRequest{UserId: alice})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recs.Total != 2 || recs.Recommendations[0].Id != pie.Id || recs.Recommendations[1].Id != bread.Id {
+		t.Fatalf("recommendations = %v", recs.Recommendations)
+	}
+}
+
+func TestUpdateWorkingHours(t *testing.T) {
+	db := newTestDB(t)
+	repo, _ := newOrderRepository(db)
+	ctx := context.Background()
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+
+	hours := []*pb.WorkingHours{{DayOfWeek: 0, OpenTime: "10:00", CloseTime: "14:00"}, {DayOfWeek: 1, OpenTime: "09:00", CloseTime: "21:00"}}
+	resp, err := repo.UpdateWorkingHours(ctx, &pb.UpdateWorkingHoursRequest{KitchenId: kitchenID, WorkingHours: hours})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.KitchenId != kitchenID || len(resp.WorkingHours) != 2 || resp.UpdatedAt == "" {
+		t.Fatalf("UpdateWorkingHours = %v", resp)
+	}
+
+	var stored string
+	if err := db.QueryRow(`select working_hours::text from kitchens where id = $1`, kitchenID).Scan(&stored); err != nil {
+		t.Fatal(err)
+	}
+	want := `[{"open_time": "10:00", "close_time": "14:00", "day_of_week": 0}, {"open_time": "09:00", "close_time": "21:00", "day_of_week": 1}]`
+	if stored != want {
+		t.Fatalf("working_hours = %s, want %s", stored, want)
+	}
+
+	if _, err := repo.UpdateWorkingHours(ctx, &pb.UpdateWorkingHoursRequest{KitchenId: "00000000-0000-0000-0000-000000000000"}); !errors.Is(err, ErrKitchenNotFound) {
+		t.Fatalf("missing kitchen: %v", err)
+	}
+}
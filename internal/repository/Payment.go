@@ -0,0 +1,520 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPaymentNotFound      = apperr.New(apperr.NotFound, "payment not found")
+	ErrPaymentRequired      = apperr.New(apperr.Precondition, "order needs a captured or cash-on-delivery payment covering its total")
+	ErrPaymentNotRefundable = apperr.New(apperr.Precondition, "only captured payments can be refunded")
+	ErrRefundExceeded       = apperr.New(apperr.Precondition, "refund exceeds the captured amount minus prior refunds")
+	ErrItemNotInOrder       = apperr.Invalid("dish_id", "dish is not part of the order")
+	ErrProviderMismatch     = apperr.New(apperr.Precondition, "payment was made with a different payment provider")
+	ErrOrderPaid            = apperr.New(apperr.Precondition, "order is already paid in full")
+	ErrAmountNotOutstanding = apperr.Invalid("payment.amount", "amount must equal the order's outstanding balance")
+	ErrOrderNotPayable      = apperr.New(apperr.Precondition, "only pending orders can be paid")
+)
+
+// ValidatePayment checks the fields CreatePayment needs.
+func ValidatePayment(p *pb.Payment) error {
+	if p == nil {
+		return apperr.Invalid("payment", "payment is required")
+	}
+	var missing []apperr.FieldViolation
+	if p.OrderId == "" {
+		missing = append(missing, apperr.FieldViolation{Field: "payment.order_id", Description: "order_id is required"})
+	}
+	if p.PaymentMethod == "" {
+		missing = append(missing, apperr.FieldViolation{Field: "payment.payment_method", Description: "payment_method is required"})
+	}
+	if len(missing) > 0 {
+		return &apperr.Error{Kind: apperr.Validation, Message: "order_id and payment_method are required", Violations: missing}
+	}
+	return nil
+}
+
+const paymentColumns = `id, order_id, amount, status, payment_method, provider, coalesce(transaction_id, ''), created_at, updated_at,
+	refunded_amount, coalesce(refund_transaction_id, ''), coalesce(card_token, ''), coalesce(card_last4, ''), coalesce(card_brand, '')`
+
+func scanPayment(row interface{ Scan(...any) error }, payment *pb.Payment) error {
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&payment.Id, &payment.OrderId, &payment.Amount, &payment.Status, &payment.PaymentMethod, &payment.Provider, &payment.TransactionId, &createdAt, &updatedAt,
+		&payment.RefundedAmount, &payment.RefundTransactionId, &payment.CardToken, &payment.CardLast4, &payment.CardBrand); err != nil {
+		return err
+	}
+	payment.CreatedAt = createdAt.Format(time.RFC3339)
+	payment.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// CreatePayment records the payment of a pending order's outstanding balance
+// as pending, then authorizes it with the provider and, except for cash on
+// delivery, captures it straight away. The stored status follows each step,
+// so a payment the provider declines is kept as failed.
+func (o *OrderRepository) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ValidatePayment(req.Payment); err != nil {
+		return nil, err
+	}
+	if !payment.Serves(o.Payments, req.Payment.PaymentMethod) {
+		return nil, payment.ErrMethodNotServed
+	}
+
+	provider := o.Payments
+	record, err := o.insertPayment(ctx, req.Payment, provider.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := provider.Authorize(ctx, payment.AuthorizeRequest{
+		OrderID: req.Payment.OrderId,
+		Amount:  req.Payment.Amount,
+		Method:  req.Payment.PaymentMethod,
+		Card:    payment.Card{Token: req.Payment.CardToken, Last4: req.Payment.CardLast4, Brand: req.Payment.CardBrand},
+	})
+	if err != nil {
+		return nil, o.failPayment(ctx, record, err)
+	}
+	if err = o.setPaymentStatus(ctx, record, payment.StatusAuthorized, txID); err != nil {
+		return nil, err
+	}
+
+	if provider.Name() != payment.ProviderCashOnDelivery {
+		if err = provider.Capture(ctx, txID, req.Payment.Amount); err != nil {
+			provider.Void(ctx, txID)
+			return nil, o.failPayment(ctx, record, err)
+		}
+		if err = o.setPaymentStatus(ctx, record, payment.StatusCaptured, txID); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("Payment created successfully:", zap.String("payment_id", record.Id), zap.String("status", record.Status))
+	return &pb.CreatePaymentResponse{Payment: record}, nil
+}
+
+// insertPayment records the payment as pending once the order is known to be
+// pending and to owe exactly its amount. The order row stays locked until the
+// payment is stored, so concurrent payments for the same order see each other
+// and cannot both cover the same balance.
+func (o *OrderRepository) insertPayment(ctx context.Context, p *pb.Payment, provider string) (*pb.Payment, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRowContext(ctx, `select status from orders where id::text = $1 and deleted_at is null for update`, p.OrderId).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != orderstatus.Pending {
+		return nil, fmt.Errorf("%w: order is %s", ErrOrderNotPayable, status)
+	}
+
+	due, err := unpaidBalance(ctx, tx, p.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if due <= 0 {
+		return nil, ErrOrderPaid
+	}
+	if !pricing.Equal(p.Amount, due) {
+		return nil, fmt.Errorf("%w: %.2f is outstanding", ErrAmountNotOutstanding, due)
+	}
+
+	query := `insert into payments (id, order_id, amount, status, payment_method, provider, card_token, card_last4, card_brand)
+		values ($1, $2, $3, $4, $5, $6, nullif($7, ''), nullif($8, ''), nullif($9, ''))
+		returning ` + paymentColumns
+
+	record := &pb.Payment{}
+	row := tx.QueryRowContext(ctx, query, uuid.NewString(), p.OrderId, p.Amount, payment.StatusPending, p.PaymentMethod, provider,
+		p.CardToken, p.CardLast4, p.CardBrand)
+	if err = scanPayment(row, record); err != nil {
+		log.Error("error inserting payment", zap.Error(err))
+		return nil, err
+	}
+	return record, tx.Commit()
+}
+
+func (o *OrderRepository) setPaymentStatus(ctx context.Context, record *pb.Payment, status, transactionID string) error {
+	query := `update payments set status = $1, transaction_id = coalesce(nullif($2, ''), transaction_id), updated_at = now()
+		where id = $3
+		returning ` + paymentColumns
+
+	return scanPayment(o.DB.QueryRowContext(ctx, query, status, transactionID, record.Id), record)
+}
+
+// failPayment marks the payment failed and returns the provider's error.
+func (o *OrderRepository) failPayment(ctx context.Context, record *pb.Payment, cause error) error {
+	log, err := l.NewLogger()
+	if err != nil {
+		return err
+	}
+
+	log.Warn("payment failed", zap.String("payment_id", record.Id), zap.String("order_id", record.OrderId), zap.Error(cause))
+	if err := o.setPaymentStatus(ctx, record, payment.StatusFailed, ""); err != nil {
+		log.Error("error marking payment failed", zap.String("payment_id", record.Id), zap.Error(err))
+	}
+	return cause
+}
+
+// GetPayment returns the payment with its refund ledger.
+func (o *OrderRepository) GetPayment(ctx context.Context, id string) (*pb.Payment, error) {
+	record := &pb.Payment{}
+	err := scanPayment(o.DB.QueryRowContext(ctx, `select `+paymentColumns+` from payments where id = $1`, id), record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := o.DB.QueryContext(ctx, `select `+refundColumns+` from refunds where payment_id = $1 order by created_at, id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		refund := &pb.Refund{}
+		if err := scanRefund(rows, refund); err != nil {
+			return nil, err
+		}
+		record.Refunds = append(record.Refunds, refund)
+	}
+	return record, rows.Err()
+}
+
+func (o *OrderRepository) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListPaymentsResponse{Page: req.Page, Limit: req.Limit}
+
+	where := ` from payments
+		where ($1 = '' or order_id::text = $1)
+		and ($2 = '' or order_id in (select id from orders where user_id::text = $2))
+		and ($3 = '' or order_id in (select id from orders where kitchen_id::text = $3))
+		and ($4 = '' or status = $4)`
+
+	if err = o.DB.QueryRowContext(ctx, `select count(*)`+where, req.OrderId, req.UserId, req.KitchenId, req.Status).Scan(&resp.Total); err != nil {
+		log.Error("error counting payments", zap.Error(err))
+		return nil, err
+	}
+
+	query := `select ` + paymentColumns + where + ` order by created_at desc, id limit $5 offset $6`
+
+	rows, err := o.DB.QueryContext(ctx, query, req.OrderId, req.UserId, req.KitchenId, req.Status, req.Limit, (req.Page-1)*req.Limit)
+	if err != nil {
+		log.Error("error getting payments", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		record := &pb.Payment{}
+		if err = scanPayment(rows, record); err != nil {
+			log.Error("error scanning payment", zap.Error(err))
+			return nil, err
+		}
+		resp.Payments = append(resp.Payments, record)
+	}
+	return resp, rows.Err()
+}
+
+// outstandingBalance is what is left to pay on a live order: its total minus
+// captured payments and authorized cash-on-delivery ones, net of refunds.
+func outstandingBalance(ctx context.Context, tx *sql.Tx, orderID string) (float64, error) {
+	query := `select o.total_amount - coalesce(sum(p.amount - p.refunded_amount), 0)
+		from orders o
+		left join payments p on p.order_id = o.id
+			and (p.status = 'captured' or (p.status = 'authorized' and p.provider = $2))
+		where o.id::text = $1 and o.deleted_at is null
+		group by o.id, o.total_amount`
+
+	var due float64
+	if err := tx.QueryRowContext(ctx, query, orderID, payment.ProviderCashOnDelivery).Scan(&due); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrOrderNotFound
+		}
+		return 0, err
+	}
+	return pricing.Round(due), nil
+}
+
+// unpaidBalance is what a new payment may still cover: the order's total minus
+// every payment that has not failed or been voided, including ones still
+// pending or authorized with the provider, net of refunds.
+func unpaidBalance(ctx context.Context, tx *sql.Tx, orderID string) (float64, error) {
+	query := `select o.total_amount - coalesce(sum(p.amount - p.refunded_amount), 0)
+		from orders o
+		left join payments p on p.order_id = o.id and p.status in ('pending', 'authorized', 'captured', 'partially_refunded')
+		where o.id::text = $1
+		group by o.id, o.total_amount`
+
+	var due float64
+	if err := tx.QueryRowContext(ctx, query, orderID).Scan(&due); err != nil {
+		return 0, err
+	}
+	return pricing.Round(due), nil
+}
+
+// requirePayment fails unless the order's captured payments, plus authorized
+// cash-on-delivery ones, cover its total. Refunded amounts do not count.
+func requirePayment(ctx context.Context, tx *sql.Tx, orderID string) error {
+	due, err := outstandingBalance(ctx, tx, orderID)
+	if err != nil {
+		return err
+	}
+	if due > 0 {
+		return ErrPaymentRequired
+	}
+	return nil
+}
+
+// refundOrderPayments refunds what is left of captured payments and voids
+// authorized ones. Refunds are added to the ledger.
+func (o *OrderRepository) refundOrderPayments(ctx context.Context, tx *sql.Tx, orderID, actorID, reason string) ([]*pb.Payment, error) {
+	query := `select id, status, amount - refunded_amount, provider, coalesce(transaction_id, '') from payments
+		where order_id = $1 and status in ('authorized', 'captured', 'partially_refunded') and amount > refunded_amount
+		for update`
+
+	rows, err := tx.QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	type outstanding struct {
+		id, status, provider, transactionID string
+		amount                              float64
+	}
+	var payments []outstanding
+	for rows.Next() {
+		var p outstanding
+		if err := rows.Scan(&p.id, &p.status, &p.amount, &p.provider, &p.transactionID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		payments = append(payments, p)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var refunds []*pb.Payment
+	for _, p := range payments {
+		if p.provider != o.Payments.Name() {
+			return nil, fmt.Errorf("%w: %s used %q, not %q", ErrProviderMismatch, p.id, p.provider, o.Payments.Name())
+		}
+
+		state, refundID := payment.StatusRefunded, ""
+		if p.status == payment.StatusAuthorized {
+			state = payment.StatusVoided
+			err = o.Payments.Void(ctx, p.transactionID)
+		} else {
+			refundID, err = o.Payments.Refund(ctx, p.transactionID, p.amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if refundID != "" {
+			if _, err := insertRefund(ctx, tx, &pb.Refund{PaymentId: p.id, OrderId: orderID, Amount: p.amount, Reason: reason, ProviderRefundId: refundID, ActorId: actorID}); err != nil {
+				return nil, err
+			}
+		}
+
+		update := `update payments set
+			status = $1,
+			refunded_amount = amount,
+			refund_transaction_id = nullif($2, ''),
+			refunded_at = now(),
+			updated_at = now()
+			where id = $3
+			returning ` + paymentColumns
+
+		record := &pb.Payment{}
+		if err := scanPayment(tx.QueryRowContext(ctx, update, state, refundID, p.id), record); err != nil {
+			return nil, err
+		}
+		refunds = append(refunds, record)
+	}
+	return refunds, nil
+}
+
+const refundColumns = `id, payment_id, order_id, amount, coalesce(dish_id::text, ''), coalesce(quantity, 0), coalesce(reason, ''), coalesce(provider_refund_id, ''), coalesce(actor_id::text, ''), created_at`
+
+func scanRefund(row interface{ Scan(...any) error }, refund *pb.Refund) error {
+	var createdAt time.Time
+	if err := row.Scan(&refund.Id, &refund.PaymentId, &refund.OrderId, &refund.Amount, &refund.DishId, &refund.Quantity, &refund.Reason, &refund.ProviderRefundId, &refund.ActorId, &createdAt); err != nil {
+		return err
+	}
+	refund.CreatedAt = createdAt.Format(time.RFC3339)
+	return nil
+}
+
+func insertRefund(ctx context.Context, tx *sql.Tx, refund *pb.Refund) (*pb.Refund, error) {
+	query := `insert into refunds (id, payment_id, order_id, amount, dish_id, quantity, reason, provider_refund_id, actor_id)
+		values ($1, $2, $3, $4, nullif($5, '')::uuid, nullif($6, 0), nullif($7, ''), nullif($8, ''), nullif($9, '')::uuid)
+		returning ` + refundColumns
+
+	stored := &pb.Refund{}
+	row := tx.QueryRowContext(ctx, query, uuid.NewString(), refund.PaymentId, refund.OrderId, refund.Amount, refund.DishId, refund.Quantity, refund.Reason, refund.ProviderRefundId, refund.ActorId)
+	if err := scanRefund(row, stored); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// RefundPayment refunds part of a captured payment, either a line item of the
+// order (all of it, or req.Quantity units) or req.Amount. Line items cannot be
+// refunded beyond the ordered quantity, and no refund may exceed what is left
+// of the payment.
+func (o *OrderRepository) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest, actorID string) (*pb.RefundPaymentResponse, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var orderID, state, provider, transactionID string
+	var remaining float64
+	err = tx.QueryRowContext(ctx, `select order_id, status, provider, coalesce(transaction_id, ''), amount - refunded_amount from payments where id = $1 for update`, req.PaymentId).
+		Scan(&orderID, &state, &provider, &transactionID, &remaining)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if state != payment.StatusCaptured && state != payment.StatusPartiallyRefunded {
+		return nil, fmt.Errorf("%w: payment is %s", ErrPaymentNotRefundable, state)
+	}
+	if provider != o.Payments.Name() {
+		return nil, fmt.Errorf("%w: %s used %q, not %q", ErrProviderMismatch, req.PaymentId, provider, o.Payments.Name())
+	}
+
+	amount, quantity := req.Amount, req.Quantity
+	if req.DishId != "" {
+		amount, quantity, err = lineItemRefund(ctx, tx, orderID, req.DishId, quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	amount = pricing.Round(amount)
+	if amount <= 0 {
+		return nil, payment.ErrInvalidAmount
+	}
+	if amount > pricing.Round(remaining) {
+		return nil, fmt.Errorf("%w: %.2f left", ErrRefundExceeded, remaining)
+	}
+
+	refundID, err := o.Payments.Refund(ctx, transactionID, amount)
+	if err != nil {
+		log.Error("error refunding payment", zap.String("payment_id", req.PaymentId), zap.Error(err))
+		return nil, err
+	}
+
+	refund, err := insertRefund(ctx, tx, &pb.Refund{
+		PaymentId:        req.PaymentId,
+		OrderId:          orderID,
+		Amount:           amount,
+		DishId:           req.DishId,
+		Quantity:         quantity,
+		Reason:           req.Reason,
+		ProviderRefundId: refundID,
+		ActorId:          actorID,
+	})
+	if err != nil {
+		log.Error("error recording refund", zap.String("payment_id", req.PaymentId), zap.Error(err))
+		return nil, err
+	}
+
+	update := `update payments set
+		refunded_amount = refunded_amount + $1,
+		status = case when refunded_amount + $1 >= amount then 'refunded' else 'partially_refunded' end,
+		refund_transaction_id = $2,
+		refunded_at = now(),
+		updated_at = now()
+		where id = $3
+		returning ` + paymentColumns
+
+	record := &pb.Payment{}
+	if err = scanPayment(tx.QueryRowContext(ctx, update, amount, refundID, req.PaymentId), record); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	log.Info("refund payment", zap.String("payment_id", record.Id), zap.String("refund_id", refund.Id), zap.Float64("amount", refund.Amount))
+	return &pb.RefundPaymentResponse{Payment: record, Refund: refund}, nil
+}
+
+// lineItemRefund prices a refund of quantity units of the dish, or all units
+// not yet refunded when quantity is 0. An order may list the dish on several
+// lines, which are refunded as one. Each unit is refunded at what the customer
+// paid for it, after the order's discount and with its tax.
+func lineItemRefund(ctx context.Context, tx *sql.Tx, orderID, dishID string, quantity int32) (float64, int32, error) {
+	query := `select sum(oi.price * oi.quantity), sum(oi.quantity) - coalesce((
+			select sum(r.quantity) from refunds r where r.order_id = o.id and r.dish_id::text = $2
+		), 0), sum(oi.quantity), o.subtotal, o.discount_amount, o.tax_amount
+		from order_items oi
+		join orders o on o.id = oi.order_id
+		where oi.order_id = $1 and oi.dish_id::text = $2
+		group by o.id, o.subtotal, o.discount_amount, o.tax_amount`
+
+	var gross float64
+	var left, ordered int32
+	var order pricing.Breakdown
+	err := tx.QueryRowContext(ctx, query, orderID, dishID).Scan(&gross, &left, &ordered, &order.Subtotal, &order.Discount, &order.Tax)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, 0, ErrItemNotInOrder
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if quantity == 0 {
+		quantity = left
+	}
+	if quantity <= 0 || quantity > left {
+		return 0, 0, fmt.Errorf("%w: %d of the dish left to refund", ErrRefundExceeded, left)
+	}
+	return pricing.ItemRefund(order, gross*float64(quantity)/float64(ordered)), quantity, nil
+}
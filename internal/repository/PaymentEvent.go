@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/webhook"
+)
+
+// The methods below implement webhook.Store.
+
+func (o *OrderRepository) EventSeen(ctx context.Context, eventID string) (bool, error) {
+	var seen bool
+	err := o.DB.QueryRowContext(ctx, `select exists(select 1 from payment_events where id = $1)`, eventID).Scan(&seen)
+	return seen, err
+}
+
+func (o *OrderRepository) RecordEvent(ctx context.Context, event webhook.Event, paymentID string) error {
+	query := `insert into payment_events (id, payment_id, type, created_at) values ($1, $2, $3, $4) on conflict (id) do nothing`
+
+	_, err := o.DB.ExecContext(ctx, query, event.ID, paymentID, event.Type, time.Unix(event.Created, 0))
+	return err
+}
+
+func (o *OrderRepository) PaymentByTransaction(ctx context.Context, provider, transactionID string) (*pb.Payment, error) {
+	query := `select ` + paymentColumns + ` from payments where provider = $1 and transaction_id = $2 order by created_at desc limit 1`
+
+	record := &pb.Payment{}
+	err := scanPayment(o.DB.QueryRowContext(ctx, query, provider, transactionID), record)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, webhook.ErrPaymentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// UpdatePaymentStatus moves the payment from one status to another. A refund
+// adds what was left of the payment to its refunded amount and to the refunds
+// ledger, with the event as the provider's refund id.
+func (o *OrderRepository) UpdatePaymentStatus(ctx context.Context, paymentID, from, to, eventID string) (bool, error) {
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var orderID string
+	var remaining float64
+	err = tx.QueryRowContext(ctx, `select order_id, amount - refunded_amount from payments where id = $1 and status = $2 for update`, paymentID, from).Scan(&orderID, &remaining)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	refunded := 0.0
+	if to == payment.StatusRefunded {
+		refunded = remaining
+	}
+	query := `update payments set
+		status = $1,
+		refunded_amount = refunded_amount + $2,
+		refunded_at = case when $1 = 'refunded' then now() else refunded_at end,
+		updated_at = now()
+		where id = $3`
+
+	if _, err = tx.ExecContext(ctx, query, to, refunded, paymentID); err != nil {
+		return false, err
+	}
+	if refunded > 0 {
+		refund := &pb.Refund{PaymentId: paymentID, OrderId: orderID, Amount: refunded, Reason: "refunded by the payment provider", ProviderRefundId: eventID}
+		if _, err = insertRefund(ctx, tx, refund); err != nil {
+			return false, err
+		}
+	}
+	return true, tx.Commit()
+}
+
+func (o *OrderRepository) CancelUnpaidOrder(ctx context.Context, orderID, reason string) error {
+	tx, err := o.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var current string
+	err = tx.QueryRowContext(ctx, `select status from orders where id = $1 and deleted_at is null for update`, orderID).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if current != orderstatus.Pending {
+		return nil
+	}
+
+	err = requirePayment(ctx, tx, orderID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrPaymentRequired) {
+		return err
+	}
+
+	if _, err = transitionOrder(ctx, tx, orderID, orderstatus.Cancelled, "", reason); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
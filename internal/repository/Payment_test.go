@@ -0,0 +1,346 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/storage"
+	"Github.com/LocalEats/Order-Service/internal/webhook"
+	"Github.com/LocalEats/Order-Service/migrations"
+	"github.com/google/uuid"
+)
+
+func TestPayments(t *testing.T) {
+	db := newTestDB(t)
+	repo, fake := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	bread := createDish(t, repo, kitchenID, "Bread", 2)
+	order := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 2}, &pb.OrderItem{DishId: bread.Id, Quantity: 1})
+
+	declined, err := fake.Tokenize(ctx, payment.DeclinedCard)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.CreatePayment(ctx, &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card", CardToken: declined.Token}})
+	if !errors.Is(err, payment.ErrDeclined) {
+		t.Fatalf("declined card: %v", err)
+	}
+	_, err = repo.CreatePayment(ctx, &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: "00000000-0000-0000-0000-000000000000", Amount: 1, PaymentMethod: "card"}})
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Fatalf("paying a missing order: %v", err)
+	}
+	_, err = repo.CreatePayment(ctx, &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: 1, PaymentMethod: "card"}})
+	if !errors.Is(err, ErrAmountNotOutstanding) {
+		t.Fatalf("paying less than the total: %v", err)
+	}
+
+	paid := payOrder(t, repo, fake, order)
+	if paid.Status != payment.StatusCaptured || paid.Provider != payment.ProviderFake || paid.TransactionId == "" || paid.CardLast4 != "4242" {
+		t.Fatalf("created payment %v", paid)
+	}
+	_, err = repo.CreatePayment(ctx, &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card"}})
+	if !errors.Is(err, ErrOrderPaid) {
+		t.Fatalf("paying twice: %v", err)
+	}
+
+	failed, err := repo.ListPayments(ctx, &pb.ListPaymentsRequest{UserId: customer, Status: payment.StatusFailed, Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failed.Total != 1 || len(failed.Payments) != 1 || failed.Payments[0].Id == paid.Id {
+		t.Fatalf("failed payments = %v", failed.Payments)
+	}
+	all, err := repo.ListPayments(ctx, &pb.ListPaymentsRequest{KitchenId: kitchenID, Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if all.Total != 2 || all.Payments[0].Id != paid.Id {
+		t.Fatalf("kitchen payments = %v", all.Payments)
+	}
+
+	refund, err := repo.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: soup.Id, Quantity: 1, Reason: "cold"}, chef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund.Refund.Amount != 11 || refund.Refund.Quantity != 1 || refund.Refund.ActorId != chef || refund.Payment.Status != payment.StatusPartiallyRefunded || refund.Payment.RefundedAmount != 11 {
+		t.Fatalf("line item refund = %v", refund)
+	}
+
+	for name, tc := range map[string]struct {
+		req *pb.RefundPaymentRequest
+		err error
+	}{
+		"too many units":  {&pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: soup.Id, Quantity: 2}, ErrRefundExceeded},
+		"not in order":    {&pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: "00000000-0000-0000-0000-000000000000"}, ErrItemNotInOrder},
+		"too much":        {&pb.RefundPaymentRequest{PaymentId: paid.Id, Amount: 100}, ErrRefundExceeded},
+		"no amount":       {&pb.RefundPaymentRequest{PaymentId: paid.Id}, payment.ErrInvalidAmount},
+		"missing payment": {&pb.RefundPaymentRequest{PaymentId: "00000000-0000-0000-0000-000000000000", Amount: 1}, ErrPaymentNotFound},
+		"failed payment":  {&pb.RefundPaymentRequest{PaymentId: failed.Payments[0].Id, Amount: 1}, ErrPaymentNotRefundable},
+	} {
+		if _, err := repo.RefundPayment(ctx, tc.req, chef); !errors.Is(err, tc.err) {
+			t.Errorf("%s: got %v, want %v", name, err, tc.err)
+		}
+	}
+
+	if _, err := repo.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, Amount: 1.5}, chef); err != nil {
+		t.Fatal(err)
+	}
+	record, err := repo.GetPayment(ctx, paid.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Refunds) != 2 || record.RefundedAmount != 12.5 || record.Refunds[0].DishId != soup.Id || record.Refunds[1].DishId != "" {
+		t.Fatalf("payment after refunds = %v", record)
+	}
+	if _, err := repo.GetPayment(ctx, "00000000-0000-0000-0000-000000000000"); !errors.Is(err, ErrPaymentNotFound) {
+		t.Fatalf("GetPayment of a missing payment: %v", err)
+	}
+
+	stats, err := repo.GetKitchenStatistics(ctx, &pb.GetKitchenStatisticsRequest{KitchenId: kitchenID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.GrossRevenue != order.TotalAmount || stats.RefundedAmount != 12.5 || stats.TotalRevenue != order.TotalAmount-12.5 {
+		t.Fatalf("statistics after refunds = %v", stats)
+	}
+}
+
+func TestConcurrentPayments(t *testing.T) {
+	db := newTestDB(t)
+	repo, fake := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	kitchenID := seedKitchen(t, db, seedUser(t, db, "chef"))
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	card, err := fake.Tokenize(ctx, "4242424242424242")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A payment still pending with the provider counts against the balance.
+	order := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	pendingID := uuid.NewString()
+	_, err = db.Exec(`insert into payments (id, order_id, amount, status, payment_method, provider) values ($1, $2, $3, 'pending', 'card', $4)`,
+		pendingID, order.Id, order.TotalAmount, payment.ProviderFake)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card", CardToken: card.Token}}
+	if _, err := repo.CreatePayment(ctx, req); !errors.Is(err, ErrOrderPaid) {
+		t.Fatalf("paying over a pending payment: %v", err)
+	}
+	if _, err := db.Exec(`update payments set status = 'failed' where id = $1`, pendingID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.CreatePayment(ctx, req); err != nil {
+		t.Fatalf("paying after the pending payment failed: %v", err)
+	}
+
+	// Of several payments racing for the same balance only one is taken.
+	order = createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	req = &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card", CardToken: card.Token}}
+	errs := make(chan error, 5)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			_, err := repo.CreatePayment(ctx, req)
+			errs <- err
+		}()
+	}
+	captured := 0
+	for i := 0; i < cap(errs); i++ {
+		switch err := <-errs; {
+		case err == nil:
+			captured++
+		case !errors.Is(err, ErrOrderPaid):
+			t.Errorf("concurrent payment: %v", err)
+		}
+	}
+	if captured != 1 {
+		t.Fatalf("%d concurrent payments were captured, want 1", captured)
+	}
+}
+
+func TestLineItemRefunds(t *testing.T) {
+	db := newTestDB(t)
+	repo, fake := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	bread := createDish(t, repo, kitchenID, "Bread", 2)
+	if _, err := db.Exec(`insert into discounts (code, kitchen_id, percent_off) values ('SAVE10', $1, 10)`, kitchenID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Soup is listed twice. Subtotal 32, discount 3.20, tax 2.88 on 28.80,
+	// so each unit of soup cost the customer 10 * 31.68 / 32 = 9.90.
+	created, err := repo.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId:       customer,
+		KitchenId:    kitchenID,
+		DiscountCode: "SAVE10",
+		Items: []*pb.OrderItem{
+			{DishId: soup.Id, Quantity: 1},
+			{DishId: bread.Id, Quantity: 1},
+			{DishId: soup.Id, Quantity: 2},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	paid := payOrder(t, repo, fake, created.Order)
+
+	for _, tc := range []struct {
+		quantity int32
+		amount   float64
+		units    int32
+	}{
+		{quantity: 2, amount: 19.8, units: 2},
+		{quantity: 0, amount: 9.9, units: 1},
+	} {
+		refund, err := repo.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: soup.Id, Quantity: tc.quantity}, chef)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if refund.Refund.Amount != tc.amount || refund.Refund.Quantity != tc.units {
+			t.Fatalf("refunding %d units of soup = %.2f for %d, want %.2f for %d", tc.quantity, refund.Refund.Amount, refund.Refund.Quantity, tc.amount, tc.units)
+		}
+	}
+	if _, err := repo.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: soup.Id, Quantity: 1}, chef); !errors.Is(err, ErrRefundExceeded) {
+		t.Fatalf("refunding a fourth unit of soup: %v", err)
+	}
+}
+
+func TestPaymentEvents(t *testing.T) {
+	db := newTestDB(t)
+	repo, fake := newOrderRepository(db)
+	ctx := context.Background()
+
+	customer := seedUser(t, db, "customer")
+	chef := seedUser(t, db, "chef")
+	kitchenID := seedKitchen(t, db, chef)
+	soup := createDish(t, repo, kitchenID, "Soup", 10)
+	order := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	paid := payOrder(t, repo, fake, order)
+	if _, err := repo.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, Amount: 3, Reason: "late"}, chef); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := repo.PaymentByTransaction(ctx, payment.ProviderFake, paid.TransactionId)
+	if err != nil || found.Id != paid.Id {
+		t.Fatalf("PaymentByTransaction = %v, %v", found, err)
+	}
+	if _, err := repo.PaymentByTransaction(ctx, payment.ProviderFake, "unknown"); !errors.Is(err, webhook.ErrPaymentNotFound) {
+		t.Fatalf("unknown transaction: %v", err)
+	}
+
+	event := webhook.Event{ID: "evt_1", Type: "payment.refunded", TransactionID: paid.TransactionId, Created: time.Now().Unix()}
+	seen, err := repo.EventSeen(ctx, event.ID)
+	if err != nil || seen {
+		t.Fatalf("EventSeen before recording = %v, %v", seen, err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := repo.RecordEvent(ctx, event, paid.Id); err != nil {
+			t.Fatalf("RecordEvent #%d: %v", i+1, err)
+		}
+	}
+	if seen, err = repo.EventSeen(ctx, event.ID); err != nil || !seen {
+		t.Fatalf("EventSeen after recording = %v, %v", seen, err)
+	}
+
+	updated, err := repo.UpdatePaymentStatus(ctx, paid.Id, payment.StatusCaptured, payment.StatusRefunded, event.ID)
+	if err != nil || updated {
+		t.Fatalf("updating from the wrong status = %v, %v", updated, err)
+	}
+	if updated, err = repo.UpdatePaymentStatus(ctx, paid.Id, payment.StatusPartiallyRefunded, payment.StatusRefunded, event.ID); err != nil || !updated {
+		t.Fatalf("UpdatePaymentStatus = %v, %v", updated, err)
+	}
+	record, err := repo.GetPayment(ctx, paid.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Status != payment.StatusRefunded || record.RefundedAmount != record.Amount || len(record.Refunds) != 2 {
+		t.Fatalf("payment after the refund event = %v", record)
+	}
+	// The ledger adds up to the refunded amount: the chef's refund plus the rest.
+	if provider := record.Refunds[1]; provider.Amount != record.Amount-3 || provider.ProviderRefundId != event.ID || provider.ActorId != "" {
+		t.Fatalf("refund recorded for the event = %v", provider)
+	}
+
+	// The refunded payment no longer covers the order, so it can be cancelled.
+	if err := repo.CancelUnpaidOrder(ctx, order.Id, "payment refunded"); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := repo.GetOrderByID(ctx, order.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Status != orderstatus.Cancelled {
+		t.Fatalf("order is %s after CancelUnpaidOrder", stored.Status)
+	}
+
+	covered := createOrder(t, repo, customer, kitchenID, &pb.OrderItem{DishId: soup.Id, Quantity: 1})
+	payOrder(t, repo, fake, covered)
+	if err := repo.CancelUnpaidOrder(ctx, covered.Id, "payment failed"); err != nil {
+		t.Fatal(err)
+	}
+	if stored, err = repo.GetOrderByID(ctx, covered.Id); err != nil || stored.Status != orderstatus.Pending {
+		t.Fatalf("a paid order was cancelled: %v, %v", stored, err)
+	}
+
+	// Rolling back refuses to drop the provider's refund or the service's own
+	// status change instead of losing them.
+	migrator, err := storage.NewMigrator(db, migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	countNull := func(table string) int {
+		t.Helper()
+		var n int
+		if err := db.QueryRow(`select count(*) from ` + table + ` where actor_id is null`).Scan(&n); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+	rollBackTo := func(version uint) error {
+		t.Helper()
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = migrator.Down(ctx, int(status.Version-version))
+		return err
+	}
+
+	if err := rollBackTo(12); err == nil {
+		t.Fatal("rolled back past provider refunds without an actor")
+	}
+	if n := countNull("refunds"); n != 1 {
+		t.Fatalf("%d refunds without an actor after the failed rollback, want 1", n)
+	}
+	if _, err := db.Exec(`update refunds set actor_id = $1 where actor_id is null`, chef); err != nil {
+		t.Fatal(err)
+	}
+	if err := migrator.Force(ctx, 13); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rollBackTo(10); err == nil {
+		t.Fatal("rolled back past status history without an actor")
+	}
+	if n := countNull("order_status_history"); n != 1 {
+		t.Fatalf("%d status changes without an actor after the failed rollback, want 1", n)
+	}
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pq "github.com/lib/pq"
+)
+
+// TokenRepository is the Postgres backed denylist used by token.Manager.
+type TokenRepository struct {
+	DB *sql.DB
+}
+
+func NewTokenRepository(db *sql.DB) *TokenRepository {
+	return &TokenRepository{DB: db}
+}
+
+func (t *TokenRepository) Revoke(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	query := `insert into revoked_tokens (id, expires_at) values ($1, $2) on conflict (id) do nothing`
+
+	result, err := t.DB.ExecContext(ctx, query, id, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return inserted == 1, nil
+}
+
+func (t *TokenRepository) IsRevoked(ctx context.Context, ids ...string) (bool, error) {
+	query := `select exists(select 1 from revoked_tokens where id = any($1))`
+
+	var revoked bool
+	err := t.DB.QueryRowContext(ctx, query, pq.Array(ids)).Scan(&revoked)
+	return revoked, err
+}
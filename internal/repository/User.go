@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"github.com/google/uuid"
+	pq "github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type UserRepository struct {
+	DB *sql.DB
+}
+
+func NewUserRepository(db *sql.DB) *UserRepository {
+	return &UserRepository{DB: db}
+}
+
+const userColumns = `id, username, email, full_name, user_type, coalesce(address, ''), coalesce(phone_number, ''), coalesce(bio, ''), specialties, years_of_experience, is_verified, created_at, updated_at`
+
+func scanUser(row interface{ Scan(...any) error }, user *pb.User, extra ...any) error {
+	var createdAt, updatedAt time.Time
+	dest := []any{&user.Id, &user.Username, &user.Email, &user.FullName, &user.UserType, &user.Address, &user.PhoneNumber, &user.Bio, pq.Array(&user.Specialties), &user.YearsOfExperience, &user.IsVerified, &createdAt, &updatedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return err
+	}
+	user.CreatedAt = createdAt.Format(time.RFC3339)
+	user.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return nil
+}
+
+// UsernameOrEmailTaken reports which of the unique user fields are already in use.
+func (u *UserRepository) UsernameOrEmailTaken(ctx context.Context, username, email string) (usernameTaken, emailTaken bool, err error) {
+	query := `select
+		exists(select 1 from users where lower(username) = lower($1) and deleted_at is null),
+		exists(select 1 from users where lower(email) = lower($2) and deleted_at is null)`
+
+	err = u.DB.QueryRowContext(ctx, query, username, email).Scan(&usernameTaken, &emailTaken)
+	return usernameTaken, emailTaken, err
+}
+
+func (u *UserRepository) CreateUser(ctx context.Context, req *pb.RegisterRequest, passwordHash string) (*pb.User, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `insert into users (id, username, email, password_hash, full_name, user_type)
+		values ($1, $2, lower($3), $4, $5, $6)
+		returning ` + userColumns
+
+	user := &pb.User{}
+	row := u.DB.QueryRowContext(ctx, query, uuid.NewString(), req.Username, req.Email, passwordHash, req.FullName, req.UserType)
+	if err = scanUser(row, user); err != nil {
+		log.Error("error inserting user", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("insert user", zap.String("user_id", user.Id), zap.String("username", user.Username))
+	return user, nil
+}
+
+// GetUserByEmail returns the user together with its bcrypt password hash.
+func (u *UserRepository) GetUserByEmail(ctx context.Context, email string) (*pb.User, string, error) {
+	query := `select ` + userColumns + `, password_hash from users where email = lower($1) and deleted_at is null`
+
+	user := &pb.User{}
+	var passwordHash string
+	if err := scanUser(u.DB.QueryRowContext(ctx, query, email), user, &passwordHash); err != nil {
+		return nil, "", err
+	}
+	return user, passwordHash, nil
+}
+
+func (u *UserRepository) GetUserByID(ctx context.Context, id string) (*pb.User, error) {
+	query := `select ` + userColumns + ` from users where id = $1 and deleted_at is null`
+
+	user := &pb.User{}
+	if err := scanUser(u.DB.QueryRowContext(ctx, query, id), user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u *UserRepository) UpdateProfile(ctx context.Context, id string, req *pb.UpdateProfileRequest) (*pb.User, error) {
+	log, err := l.NewLogger()
+	if err != nil {
+		return nil, err
+	}
+
+	query := `update users set
+		full_name = coalesce(nullif($1, ''), full_name),
+		address = coalesce(nullif($2, ''), address),
+		phone_number = coalesce(nullif($3, ''), phone_number),
+		updated_at = now()
+		where id = $4 and deleted_at is null
+		returning ` + userColumns
+
+	user := &pb.User{}
+	row := u.DB.QueryRowContext(ctx, query, req.FullName, req.Address, req.PhoneNumber, id)
+	if err = scanUser(row, user); err != nil {
+		log.Error("error updating profile", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("update profile", zap.String("user_id", user.Id))
+	return user, nil
+}
+
+// CreatePasswordReset stores the hash of a one-time reset token for the user.
+func (u *UserRepository) CreatePasswordReset(ctx context.Context, userID, tokenHash string, expiresAt time.Time) error {
+	query := `insert into password_resets (id, user_id, token_hash, expires_at) values ($1, $2, $3, $4)`
+
+	_, err := u.DB.ExecContext(ctx, query, uuid.NewString(), userID, tokenHash, expiresAt)
+	return err
+}
+
+// ConsumePasswordReset marks the unexpired, unused reset with tokenHash as
+// used and sets the user's new password hash. It returns sql.ErrNoRows if
+// there is no such reset.
+func (u *UserRepository) ConsumePasswordReset(ctx context.Context, tokenHash, passwordHash string) (string, error) {
+	tx, err := u.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	query := `update password_resets set used_at = now()
+		where token_hash = $1 and used_at is null and expires_at > now()
+		returning user_id`
+
+	var userID string
+	if err = tx.QueryRowContext(ctx, query, tokenHash).Scan(&userID); err != nil {
+		return "", err
+	}
+
+	result, err := tx.ExecContext(ctx, `update users set password_hash = $1, updated_at = now() where id = $2 and deleted_at is null`, passwordHash, userID)
+	if err != nil {
+		return "", err
+	}
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return "", err
+	}
+	if updated != 1 {
+		return "", sql.ErrNoRows
+	}
+	return userID, tx.Commit()
+}
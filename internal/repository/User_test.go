@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+)
+
+func TestUsers(t *testing.T) {
+	db := newTestDB(t)
+	users := NewUserRepository(db)
+	ctx := context.Background()
+
+	created, err := users.CreateUser(ctx, &authpb.RegisterRequest{Username: "maria", Email: "Maria@Example.com", FullName: "Maria Rossi", UserType: "customer"}, "secret-hash")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Email != "maria@example.com" || created.UserType != "customer" {
+		t.Fatalf("created user %v", created)
+	}
+
+	usernameTaken, emailTaken, err := users.UsernameOrEmailTaken(ctx, "MARIA", "other@example.com")
+	if err != nil || !usernameTaken || emailTaken {
+		t.Fatalf("UsernameOrEmailTaken = %v, %v, %v", usernameTaken, emailTaken, err)
+	}
+	usernameTaken, emailTaken, err = users.UsernameOrEmailTaken(ctx, "other", "MARIA@example.com")
+	if err != nil || usernameTaken || !emailTaken {
+		t.Fatalf("UsernameOrEmailTaken = %v, %v, %v", usernameTaken, emailTaken, err)
+	}
+
+	byEmail, hash, err := users.GetUserByEmail(ctx, "MARIA@EXAMPLE.COM")
+	if err != nil || byEmail.Id != created.Id || hash != "secret-hash" {
+		t.Fatalf("GetUserByEmail = %v, %q, %v", byEmail, hash, err)
+	}
+	if _, _, err := users.GetUserByEmail(ctx, "nobody@example.com"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("GetUserByEmail of a missing user: %v", err)
+	}
+
+	updated, err := users.UpdateProfile(ctx, created.Id, &authpb.UpdateProfileRequest{Address: "1 Main St"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.FullName != "Maria Rossi" || updated.Address != "1 Main St" {
+		t.Fatalf("updated profile %v", updated)
+	}
+	byID, err := users.GetUserByID(ctx, created.Id)
+	if err != nil || byID.Address != "1 Main St" {
+		t.Fatalf("GetUserByID = %v, %v", byID, err)
+	}
+
+	if err := users.CreatePasswordReset(ctx, created.Id, "token-hash", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	var resets int
+	if err := db.QueryRow(`select count(*) from password_resets where user_id = $1 and token_hash = 'token-hash'`, created.Id).Scan(&resets); err != nil || resets != 1 {
+		t.Fatalf("stored %d password resets: %v", resets, err)
+	}
+
+	userID, err := users.ConsumePasswordReset(ctx, "token-hash", "new-hash")
+	if err != nil || userID != created.Id {
+		t.Fatalf("ConsumePasswordReset = %q, %v", userID, err)
+	}
+	if _, hash, err = users.GetUserByEmail(ctx, created.Email); err != nil || hash != "new-hash" {
+		t.Fatalf("password hash after the reset = %q, %v", hash, err)
+	}
+	if _, err := users.ConsumePasswordReset(ctx, "token-hash", "other-hash"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("reusing a reset token: %v", err)
+	}
+	if err := users.CreatePasswordReset(ctx, created.Id, "expired-hash", time.Now().Add(-time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := users.ConsumePasswordReset(ctx, "expired-hash", "other-hash"); !errors.Is(err, sql.ErrNoRows) {
+		t.Fatalf("using an expired reset token: %v", err)
+	}
+}
+
+func TestRevokedTokens(t *testing.T) {
+	db := newTestDB(t)
+	tokens := NewTokenRepository(db)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour)
+
+	revoked, err := tokens.IsRevoked(ctx, "access-1", "refresh-1")
+	if err != nil || revoked {
+		t.Fatalf("IsRevoked before revoking = %v, %v", revoked, err)
+	}
+	if inserted, err := tokens.Revoke(ctx, "refresh-1", expires); err != nil || !inserted {
+		t.Fatalf("Revoke = %v, %v", inserted, err)
+	}
+	if inserted, err := tokens.Revoke(ctx, "refresh-1", expires); err != nil || inserted {
+		t.Fatalf("revoking twice = %v, %v", inserted, err)
+	}
+	if revoked, err = tokens.IsRevoked(ctx, "access-1", "refresh-1"); err != nil || !revoked {
+		t.Fatalf("IsRevoked after revoking = %v, %v", revoked, err)
+	}
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+
+	authpb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pgtest"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/storage"
+	"Github.com/LocalEats/Order-Service/migrations"
+	"github.com/google/uuid"
+)
+
+// The tests in this package run against their own migrated schema; see
+// pgtest for how the Postgres server is found.
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
+
+// newTestDB migrates a new schema for the test, or skips it when there is no
+// Postgres server.
+func newTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db := pgtest.Open(t)
+	migrator, err := storage.NewMigrator(db, migrations.FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("migrating: %v", err)
+	}
+	return db
+}
+
+func newOrderRepository(db *sql.DB) (*OrderRepository, *payment.Fake) {
+	fake := payment.NewFake()
+	return NewOrderRepository(db, pricing.Fees{DeliveryFee: 2, TaxRate: 0.1}, fake, pagetoken.New([]byte("test"))), fake
+}
+
+func seedUser(t *testing.T, db *sql.DB, userType string) string {
+	t.Helper()
+	id := uuid.NewString()
+	user, err := NewUserRepository(db).CreateUser(context.Background(), &authpb.RegisterRequest{
+		Username: "user-" + id[:8],
+		Email:    id[:8] + "@example.com",
+		FullName: "Test User",
+		UserType: userType,
+	}, "hash")
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	return user.Id
+}
+
+func seedKitchen(t *testing.T, db *sql.DB, ownerID string) string {
+	t.Helper()
+	kitchen, err := NewKitchenRepository(db).CreateKitchen(context.Background(), ownerID, &authpb.CreateKitchenRequest{Name: "Mama's", CuisineType: "Italian"})
+	if err != nil {
+		t.Fatalf("CreateKitchen: %v", err)
+	}
+	return kitchen.Id
+}
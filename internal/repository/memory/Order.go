@@ -0,0 +1,924 @@
+// Package memory keeps the order store in process memory. It has the same
+// semantics as repository.OrderRepository, including its errors, and is meant
+// for service tests and local demos that run without Postgres.
+package memory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// recommendationLimit caps GetDishRecommendations.
+const recommendationLimit = 10
+
+// Kitchen is the part of a kitchen the order store reads. Nil fees fall back
+// to the store's defaults.
+type Kitchen struct {
+	ID           string
+	OwnerID      string
+	Name         string
+	DeliveryFee  *float64
+	TaxRate      *float64
+	WorkingHours []*pb.WorkingHours
+	updatedAt    time.Time
+}
+
+type Discount struct {
+	Code      string
+	KitchenID string
+	pricing.Discount
+	Active    bool
+	ExpiresAt time.Time
+}
+
+type dishRecord struct {
+	dish      *pb.Dish
+	createdAt time.Time
+	calories  int32
+	protein   int32
+	carbs     int32
+	fat       int32
+	deletedAt time.Time
+}
+
+type orderRecord struct {
+	order     *pb.Order
+	createdAt time.Time
+	history   []*pb.OrderStatusChange
+}
+
+type reviewRecord struct {
+	review    *pb.Review
+	createdAt time.Time
+}
+
+func (r *dishRecord) key() pagetoken.Cursor {
+	return pagetoken.Cursor{CreatedAt: r.createdAt, ID: r.dish.Id}
+}
+
+func (r *orderRecord) key() pagetoken.Cursor {
+	return pagetoken.Cursor{CreatedAt: r.createdAt, ID: r.order.Id}
+}
+
+func (r *reviewRecord) key() pagetoken.Cursor {
+	return pagetoken.Cursor{CreatedAt: r.createdAt, ID: r.review.Id}
+}
+
+// rankedDish is a dish in a listing sorted by value.
+type rankedDish struct {
+	*dishRecord
+	value float64
+}
+
+func (r rankedDish) key() pagetoken.Cursor {
+	key := r.dishRecord.key()
+	key.Value = r.value
+	return key
+}
+
+// OrderStore is safe for concurrent use. Every write holds the lock for the
+// whole call, so it behaves like the repository's transactions. Messages are
+// cloned on the way in and out.
+type OrderStore struct {
+	Fees     pricing.Fees
+	Payments payment.Provider
+	Pages    *pagetoken.Codec
+
+	mu        sync.RWMutex
+	seq       int
+	now       func() time.Time
+	kitchens  map[string]*Kitchen
+	discounts map[string]*Discount
+	dishes    map[string]*dishRecord
+	orders    map[string]*orderRecord
+	reviews   map[string]*reviewRecord
+	payments  map[string]*paymentRecord
+	refunds   []*pb.Refund
+}
+
+func NewOrderStore(fees pricing.Fees, payments payment.Provider, pages *pagetoken.Codec) *OrderStore {
+	return &OrderStore{
+		Fees:      fees,
+		Payments:  payments,
+		Pages:     pages,
+		now:       func() time.Time { return time.Now().UTC() },
+		kitchens:  map[string]*Kitchen{},
+		discounts: map[string]*Discount{},
+		dishes:    map[string]*dishRecord{},
+		orders:    map[string]*orderRecord{},
+		reviews:   map[string]*reviewRecord{},
+		payments:  map[string]*paymentRecord{},
+	}
+}
+
+// PutKitchen adds or replaces a kitchen. Kitchens are owned by the auth
+// service, so the order store only needs them seeded.
+func (s *OrderStore) PutKitchen(kitchen Kitchen) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	kitchen.updatedAt = s.now()
+	s.kitchens[kitchen.ID] = &kitchen
+}
+
+// PutDiscount adds or replaces a discount code. Codes match case-insensitively.
+func (s *OrderStore) PutDiscount(discount Discount) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.discounts[strings.ToUpper(discount.Code)] = &discount
+}
+
+func (s *OrderStore) next() int {
+	s.seq++
+	return s.seq
+}
+
+func timestamp(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+func clone[M proto.Message](m M) M {
+	return proto.Clone(m).(M)
+}
+
+// paginate returns the 1-based page of items. A limit of 0 returns everything.
+func paginate[T any](items []T, page, limit int32) []T {
+	if limit <= 0 {
+		return items
+	}
+	if page < 1 {
+		page = 1
+	}
+	start := int((page - 1) * limit)
+	if start >= len(items) {
+		return nil
+	}
+	return items[start:min(start+int(limit), len(items))]
+}
+
+// keyed is a record in a listing ordered by (value, created_at, id), where
+// the value is the sort key of listings sorted by something other than
+// creation time and zero otherwise.
+type keyed interface {
+	key() pagetoken.Cursor
+}
+
+// cursorKey is the row a page token names.
+type cursorKey struct{ *pagetoken.Cursor }
+
+func (c cursorKey) key() pagetoken.Cursor { return *c.Cursor }
+
+// keyBefore reports whether a sorts before b in ascending key order.
+func keyBefore(a, b keyed) bool {
+	ak, bk := a.key(), b.key()
+	if ak.Value != bk.Value {
+		return ak.Value < bk.Value
+	}
+	if !ak.CreatedAt.Equal(bk.CreatedAt) {
+		return ak.CreatedAt.Before(bk.CreatedAt)
+	}
+	return ak.ID < bk.ID
+}
+
+// sortKeyed sorts records by key, descending when desc is set.
+func sortKeyed[T keyed](records []T, desc bool) {
+	sort.Slice(records, func(i, j int) bool {
+		if desc {
+			return keyBefore(records[j], records[i])
+		}
+		return keyBefore(records[i], records[j])
+	})
+}
+
+// keysetPage returns the page of sorted records a listing request asks for,
+// the way the repository pages: after the row named by the page token, or
+// without one at the offset of the 1-based page. It also returns the page
+// number and the token of the following page, if there is one.
+func keysetPage[T keyed](pages *pagetoken.Codec, scope, token string, page, limit int32, records []T, desc bool) ([]T, int32, string, error) {
+	cursor, err := pages.Decode(scope, token)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	start := 0
+	if cursor != nil {
+		page = cursor.Page
+		last := cursorKey{cursor}
+		start = sort.Search(len(records), func(i int) bool {
+			if desc {
+				return keyBefore(records[i], last)
+			}
+			return keyBefore(last, records[i])
+		})
+	} else if limit > 0 && page > 1 {
+		start = min(int((page-1)*limit), len(records))
+	}
+	records = records[start:]
+
+	if limit <= 0 || len(records) <= int(limit) {
+		return records, page, "", nil
+	}
+	records = records[:limit]
+	last := records[len(records)-1].key()
+	last.Page = max(page, 1) + 1
+	return records, page, pages.Encode(scope, last), nil
+}
+
+func (s *OrderStore) CreateDish(ctx context.Context, req *pb.CreateDishRequest) (*pb.CreateDishResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.kitchens[req.Dish.KitchenId]; !ok {
+		return nil, repository.ErrKitchenNotFound
+	}
+
+	now := s.now()
+	dish := clone(req.Dish)
+	dish.Id = uuid.NewString()
+	dish.CreatedAt = timestamp(now)
+	dish.UpdatedAt = dish.CreatedAt
+	s.dishes[dish.Id] = &dishRecord{dish: dish, createdAt: now}
+
+	return &pb.CreateDishResponse{Dish: clone(dish)}, nil
+}
+
+func (s *OrderStore) liveDish(id string) (*dishRecord, error) {
+	record, ok := s.dishes[id]
+	if !ok || !record.deletedAt.IsZero() {
+		return nil, repository.ErrDishNotFound
+	}
+	return record, nil
+}
+
+func (s *OrderStore) UpdateDish(ctx context.Context, req *pb.UpdateDishRequest) (*pb.UpdateDishResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.liveDish(req.Dish.Id)
+	if err != nil {
+		return nil, err
+	}
+	record.dish.Name = req.Dish.Name
+	record.dish.Price = req.Dish.Price
+	record.dish.Available = req.Dish.Available
+	record.dish.UpdatedAt = timestamp(s.now())
+
+	return &pb.UpdateDishResponse{Dish: clone(record.dish)}, nil
+}
+
+func (s *OrderStore) DeleteDish(ctx context.Context, req *pb.DeleteDishRequest) (*pb.DeleteDishResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.liveDish(req.DishId)
+	if err != nil {
+		return nil, err
+	}
+	record.deletedAt = s.now()
+
+	return &pb.DeleteDishResponse{Message: "Dish successfully deleted"}, nil
+}
+
+// GetDishes lists the dishes matching the request's filters. Without a
+// kitchen it lists the whole catalogue.
+func (s *OrderStore) GetDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var value func(*dishRecord) float64
+	desc := false
+	switch req.SortBy {
+	case "":
+	case repository.DishSortNewest:
+		desc = true
+	case repository.DishSortPriceAsc, repository.DishSortPriceDesc:
+		value = func(record *dishRecord) float64 { return record.dish.Price }
+		desc = req.SortBy == repository.DishSortPriceDesc
+	case repository.DishSortRating:
+		ratings := s.dishRatings()
+		value = func(record *dishRecord) float64 { return ratings[record.dish.Id] }
+		desc = true
+	case repository.DishSortPopularity:
+		popularity := s.dishPopularity()
+		value = func(record *dishRecord) float64 { return float64(popularity[record.dish.Id]) }
+		desc = true
+	default:
+		return nil, repository.ErrUnknownDishSort
+	}
+	repository.NormalizeDishFilters(req)
+
+	var records []rankedDish
+	for _, record := range s.dishes {
+		if record.deletedAt.IsZero() && dishMatches(record.dish, req) {
+			ranked := rankedDish{dishRecord: record}
+			if value != nil {
+				ranked.value = value(record)
+			}
+			records = append(records, ranked)
+		}
+	}
+	sortKeyed(records, desc)
+
+	page, number, next, err := keysetPage(s.Pages, repository.DishScope(req), req.PageToken, req.Page, req.Limit, records, desc)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListDishesResponse{Total: int32(len(records)), Page: number, Limit: req.Limit, NextPageToken: next}
+	for _, record := range page {
+		resp.Dishes = append(resp.Dishes, clone(record.dish))
+	}
+	return resp, nil
+}
+
+// dishMatches applies the normalized filters of req to dish. A dish that lists
+// any excluded allergen never matches.
+func dishMatches(dish *pb.Dish, req *pb.ListDishesRequest) bool {
+	if req.KitchenId != "" && dish.KitchenId != req.KitchenId ||
+		req.Category != "" && strings.ToLower(dish.Category) != req.Category ||
+		req.MinPrice != 0 && dish.Price < req.MinPrice ||
+		req.MaxPrice != 0 && dish.Price > req.MaxPrice ||
+		req.AvailableOnly && !dish.Available {
+		return false
+	}
+	dietary := lowerTags(dish.DietaryInfo)
+	for _, tag := range req.DietaryInfo {
+		if !dietary[tag] {
+			return false
+		}
+	}
+	allergens := lowerTags(dish.Allergens)
+	for _, allergen := range req.ExcludeAllergens {
+		if allergens[allergen] {
+			return false
+		}
+	}
+	return true
+}
+
+func lowerTags(tags []string) map[string]bool {
+	set := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		set[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+	return set
+}
+
+// dishRatings returns the average rating of the reviewed orders that included
+// each dish.
+func (s *OrderStore) dishRatings() map[string]float64 {
+	sums, counts := map[string]float64{}, map[string]int{}
+	for _, review := range s.reviews {
+		order, ok := s.orders[review.review.OrderId]
+		if !ok {
+			continue
+		}
+		for _, item := range order.order.Items {
+			sums[item.DishId] += float64(review.review.Rating)
+			counts[item.DishId]++
+		}
+	}
+	for id, count := range counts {
+		sums[id] /= float64(count)
+	}
+	return sums
+}
+
+// dishPopularity returns the quantity ordered of each dish.
+func (s *OrderStore) dishPopularity() map[string]int32 {
+	popularity := map[string]int32{}
+	for _, record := range s.orders {
+		for _, item := range record.order.Items {
+			popularity[item.DishId] += item.Quantity
+		}
+	}
+	return popularity
+}
+
+func (s *OrderStore) UpdateDishNutritionInfo(ctx context.Context, req *pb.UpdateDishNutritionInfoRequest) (*pb.UpdateDishNutritionInfoResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.liveDish(req.DishId)
+	if err != nil {
+		return nil, err
+	}
+	record.dish.Allergens = slices.Clone(req.Allergens)
+	record.dish.DietaryInfo = slices.Clone(req.DietaryInfo)
+	record.calories, record.protein, record.carbs, record.fat = req.Calories, req.Protein, req.Carbohydrates, req.Fat
+	record.dish.UpdatedAt = timestamp(s.now())
+
+	return &pb.UpdateDishNutritionInfoResponse{Dish: clone(record.dish)}, nil
+}
+
+func (s *OrderStore) DishKitchen(ctx context.Context, dishID string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, err := s.liveDish(dishID)
+	if err != nil {
+		return "", sql.ErrNoRows
+	}
+	return record.dish.KitchenId, nil
+}
+
+func (s *OrderStore) KitchenOwner(ctx context.Context, kitchenID string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kitchen, ok := s.kitchens[kitchenID]
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	return kitchen.OwnerID, nil
+}
+
+func (s *OrderStore) UpdateWorkingHours(ctx context.Context, req *pb.UpdateWorkingHoursRequest) (*pb.UpdateWorkingHoursResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kitchen, ok := s.kitchens[req.KitchenId]
+	if !ok {
+		return nil, repository.ErrKitchenNotFound
+	}
+	kitchen.WorkingHours = nil
+	for _, hours := range req.WorkingHours {
+		kitchen.WorkingHours = append(kitchen.WorkingHours, clone(hours))
+	}
+	kitchen.updatedAt = s.now()
+
+	resp := &pb.UpdateWorkingHoursResponse{KitchenId: kitchen.ID, UpdatedAt: timestamp(kitchen.updatedAt)}
+	for _, hours := range kitchen.WorkingHours {
+		resp.WorkingHours = append(resp.WorkingHours, clone(hours))
+	}
+	return resp, nil
+}
+
+// orderView returns a copy of the order with its items and, if asked, its
+// status history.
+func orderView(record *orderRecord, history bool) *pb.Order {
+	order := clone(record.order)
+	if history {
+		for _, change := range record.history {
+			order.StatusHistory = append(order.StatusHistory, clone(change))
+		}
+	}
+	return order
+}
+
+func (s *OrderStore) liveOrder(id string) (*orderRecord, error) {
+	record, ok := s.orders[id]
+	if !ok {
+		return nil, repository.ErrOrderNotFound
+	}
+	return record, nil
+}
+
+func (s *OrderStore) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]*pb.OrderItem, 0, len(req.Order.Items))
+	for _, item := range req.Order.Items {
+		items = append(items, clone(item))
+	}
+	if err := s.snapshotItems(req.Order.KitchenId, items); err != nil {
+		return nil, err
+	}
+
+	order := clone(req.Order)
+	order.Items = items
+	breakdown, code, err := s.quoteOrder(order)
+	if err != nil {
+		return nil, err
+	}
+	if req.Order.TotalAmount != 0 && !pricing.Equal(req.Order.TotalAmount, breakdown.Total) {
+		return nil, fmt.Errorf("%w: expected %.2f", repository.ErrTotalMismatch, breakdown.Total)
+	}
+
+	now := s.now()
+	order.Id = uuid.NewString()
+	order.Status = orderstatus.Pending
+	order.DiscountCode = code
+	order.TotalAmount = breakdown.Total
+	order.PriceBreakdown = &pb.PriceBreakdown{
+		Subtotal:    breakdown.Subtotal,
+		DeliveryFee: breakdown.DeliveryFee,
+		Tax:         breakdown.Tax,
+		Discount:    breakdown.Discount,
+		Total:       breakdown.Total,
+	}
+	order.StatusHistory = nil
+	order.CreatedAt = timestamp(now)
+	order.UpdatedAt = order.CreatedAt
+	sort.SliceStable(order.Items, func(i, j int) bool { return order.Items[i].Name < order.Items[j].Name })
+
+	record := &orderRecord{order: order, createdAt: now}
+	s.recordStatusChange(record, "", orderstatus.Pending, order.UserId, "")
+	s.orders[order.Id] = record
+
+	return &pb.CreateOrderResponse{Order: orderView(record, false)}, nil
+}
+
+func (s *OrderStore) snapshotItems(kitchenID string, items []*pb.OrderItem) error {
+	if len(items) == 0 {
+		return repository.ErrNoOrderItems
+	}
+	for _, item := range items {
+		if item.Quantity <= 0 {
+			return fmt.Errorf("%w: dish %s", repository.ErrInvalidQuantity, item.DishId)
+		}
+	}
+
+	for _, item := range items {
+		record, err := s.liveDish(item.DishId)
+		switch {
+		case err != nil:
+			return fmt.Errorf("%w: %s", repository.ErrDishNotFound, item.DishId)
+		case !record.dish.Available:
+			return fmt.Errorf("%w: %s", repository.ErrDishUnavailable, item.DishId)
+		case record.dish.KitchenId != kitchenID:
+			return fmt.Errorf("%w: %s", repository.ErrDishWrongKitchen, item.DishId)
+		}
+		item.Name = record.dish.Name
+		item.Price = record.dish.Price
+	}
+	return nil
+}
+
+func (s *OrderStore) quoteOrder(order *pb.Order) (pricing.Breakdown, string, error) {
+	kitchen, ok := s.kitchens[order.KitchenId]
+	if !ok {
+		return pricing.Breakdown{}, "", repository.ErrKitchenNotFound
+	}
+	deliveryFee, taxRate := s.Fees.DeliveryFee, s.Fees.TaxRate
+	if kitchen.DeliveryFee != nil {
+		deliveryFee = *kitchen.DeliveryFee
+	}
+	if kitchen.TaxRate != nil {
+		taxRate = *kitchen.TaxRate
+	}
+
+	var discount *pricing.Discount
+	var code string
+	if order.DiscountCode != "" {
+		d, ok := s.discounts[strings.ToUpper(order.DiscountCode)]
+		if !ok || !d.Active || (d.KitchenID != "" && d.KitchenID != order.KitchenId) || (!d.ExpiresAt.IsZero() && !d.ExpiresAt.After(s.now())) {
+			return pricing.Breakdown{}, "", repository.ErrInvalidDiscount
+		}
+		discount, code = &d.Discount, d.Code
+	}
+
+	lines := make([]pricing.Line, 0, len(order.Items))
+	for _, item := range order.Items {
+		lines = append(lines, pricing.Line{Price: item.Price, Quantity: item.Quantity})
+	}
+	breakdown, err := pricing.Quote(lines, deliveryFee, taxRate, discount)
+	return breakdown, code, err
+}
+
+func (s *OrderStore) recordStatusChange(record *orderRecord, from, to, actorID, reason string) {
+	record.history = append(record.history, &pb.OrderStatusChange{
+		FromStatus: from,
+		ToStatus:   to,
+		ActorId:    actorID,
+		Reason:     reason,
+		CreatedAt:  timestamp(s.now()),
+	})
+}
+
+// transitionOrder mirrors the repository: the lifecycle must allow the move,
+// and leaving pending for anything but cancelled or rejected needs payment.
+func (s *OrderStore) transitionOrder(orderID, to, actorID, reason string) (*orderRecord, error) {
+	record, err := s.liveOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	from := record.order.Status
+	if !orderstatus.CanTransition(from, to) {
+		return nil, fmt.Errorf("%w: %s to %s", repository.ErrInvalidTransition, from, to)
+	}
+	if from == orderstatus.Pending && to != orderstatus.Cancelled && to != orderstatus.Rejected {
+		if err := s.requirePayment(record.order); err != nil {
+			return nil, err
+		}
+	}
+
+	record.order.Status = to
+	record.order.UpdatedAt = timestamp(s.now())
+	s.recordStatusChange(record, from, to, actorID, reason)
+	return record, nil
+}
+
+func (s *OrderStore) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest, actorID string) (*pb.UpdateOrderStatusResponse, error) {
+	if req.Status == orderstatus.Cancelled {
+		return nil, repository.ErrCancelViaStatus
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.transitionOrder(req.OrderId, req.Status, actorID, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateOrderStatusResponse{
+		OrderId:   req.OrderId,
+		Status:    req.Status,
+		UpdatedAt: record.order.UpdatedAt,
+	}, nil
+}
+
+func (s *OrderStore) GetOrderByID(ctx context.Context, id string) (*pb.Order, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, err := s.liveOrder(id)
+	if err != nil {
+		return nil, err
+	}
+	return orderView(record, false), nil
+}
+
+func (s *OrderStore) CancelOrder(ctx context.Context, orderID, actorID, reason string, allowedFrom []string) (*pb.CancelOrderResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, err := s.liveOrder(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(allowedFrom, record.order.Status) {
+		return nil, fmt.Errorf("%w: order is %s", repository.ErrCancellationWindow, record.order.Status)
+	}
+	if !orderstatus.CanTransition(record.order.Status, orderstatus.Cancelled) {
+		return nil, fmt.Errorf("%w: %s to %s", repository.ErrInvalidTransition, record.order.Status, orderstatus.Cancelled)
+	}
+
+	// Refund before changing anything, so a provider failure leaves the order
+	// as it was, like the repository's rolled back transaction.
+	refunds, err := s.refundOrderPayments(ctx, orderID, actorID, reason)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.transitionOrder(orderID, orderstatus.Cancelled, actorID, reason); err != nil {
+		return nil, err
+	}
+	return &pb.CancelOrderResponse{Order: orderView(record, false), Refunds: refunds}, nil
+}
+
+// sortedOrders returns the orders matching keep, newest first.
+func (s *OrderStore) sortedOrders(keep func(*pb.Order) bool) []*orderRecord {
+	var records []*orderRecord
+	for _, record := range s.orders {
+		if keep(record.order) {
+			records = append(records, record)
+		}
+	}
+	sortKeyed(records, true)
+	return records
+}
+
+func (s *OrderStore) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := s.sortedOrders(func(order *pb.Order) bool {
+		return (req.UserId == "" || order.UserId == req.UserId) &&
+			(req.KitchenId == "" || order.KitchenId == req.KitchenId) &&
+			(req.Status == "" || order.Status == req.Status)
+	})
+
+	scope := pagetoken.Scope("orders", req.UserId, req.KitchenId, req.Status)
+	page, number, next, err := keysetPage(s.Pages, scope, req.PageToken, req.Page, req.Limit, records, true)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListOrdersResponse{Total: int32(len(records)), Page: number, Limit: req.Limit, NextPageToken: next}
+	for _, record := range page {
+		resp.Orders = append(resp.Orders, orderView(record, false))
+	}
+	return resp, nil
+}
+
+func (s *OrderStore) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := s.sortedOrders(func(order *pb.Order) bool { return order.KitchenId == req.KitchenID })
+
+	page, _, next, err := keysetPage(s.Pages, pagetoken.Scope("kitchen orders", req.KitchenID), req.PageToken, req.Page, req.Limit, records, true)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.GetOrderResponse{NextPageToken: next}
+	for _, record := range page {
+		resp.Order = append(resp.Order, orderView(record, req.IncludeHistory))
+	}
+	return resp, nil
+}
+
+func (s *OrderStore) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.Review.Rating < 1 || req.Review.Rating > 5 {
+		return nil, repository.ErrInvalidRating
+	}
+	order, err := s.liveOrder(req.Review.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.order.UserId != req.Review.UserId {
+		return nil, repository.ErrReviewNotAllowed
+	}
+	for _, record := range s.reviews {
+		if record.review.OrderId == req.Review.OrderId {
+			return nil, repository.ErrReviewExists
+		}
+	}
+
+	now := s.now()
+	review := clone(req.Review)
+	review.Id = uuid.NewString()
+	review.KitchenId = order.order.KitchenId
+	review.CreatedAt = timestamp(now)
+	s.reviews[review.Id] = &reviewRecord{review: review, createdAt: now}
+
+	return &pb.CreateReviewResponse{Review: clone(review)}, nil
+}
+
+func (s *OrderStore) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []*reviewRecord
+	var sum float64
+	for _, record := range s.reviews {
+		if record.review.KitchenId == req.KitchenId {
+			records = append(records, record)
+			sum += float64(record.review.Rating)
+		}
+	}
+	sortKeyed(records, true)
+
+	page, number, next, err := keysetPage(s.Pages, pagetoken.Scope("reviews", req.KitchenId), req.PageToken, req.Page, req.Limit, records, true)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListReviewsResponse{Total: int32(len(records)), Page: number, Limit: req.Limit, NextPageToken: next}
+	if len(records) > 0 {
+		resp.AverageRating = float32(sum / float64(len(records)))
+	}
+	for _, record := range page {
+		resp.Reviews = append(resp.Reviews, clone(record.review))
+	}
+	return resp, nil
+}
+
+// GetDishRecommendations suggests available dishes the user has not ordered
+// yet, the most ordered first.
+func (s *OrderStore) GetDishRecommendations(ctx context.Context, req *pb.GetDishRecommendationsRequest) (*pb.GetDishRecommendationsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	popularity := s.dishPopularity()
+	ordered := map[string]bool{}
+	for _, record := range s.orders {
+		if record.order.UserId != req.UserId {
+			continue
+		}
+		for _, item := range record.order.Items {
+			ordered[item.DishId] = true
+		}
+	}
+
+	var dishes []*pb.Dish
+	for id, record := range s.dishes {
+		if record.deletedAt.IsZero() && record.dish.Available && !ordered[id] {
+			dishes = append(dishes, record.dish)
+		}
+	}
+	sort.Slice(dishes, func(i, j int) bool {
+		if popularity[dishes[i].Id] != popularity[dishes[j].Id] {
+			return popularity[dishes[i].Id] > popularity[dishes[j].Id]
+		}
+		if dishes[i].Name != dishes[j].Name {
+			return dishes[i].Name < dishes[j].Name
+		}
+		return dishes[i].Id < dishes[j].Id
+	})
+
+	resp := &pb.GetDishRecommendationsResponse{}
+	for _, dish := range paginate(dishes, 1, recommendationLimit) {
+		resp.Recommendations = append(resp.Recommendations, clone(dish))
+	}
+	resp.Total = int32(len(resp.Recommendations))
+	return resp, nil
+}
+
+// parseBound reads a statistics date bound, either a date or an RFC 3339
+// timestamp. An empty bound is open.
+func parseBound(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+func (s *OrderStore) GetKitchenStatistics(ctx context.Context, req *pb.GetKitchenStatisticsRequest) (*pb.GetKitchenStatisticsResponse, error) {
+	start, err := parseBound(req.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseBound(req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	within := func(t time.Time) bool {
+		return !t.Before(start) && (end.IsZero() || !t.After(end))
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := &pb.GetKitchenStatisticsResponse{}
+	counted := map[string]*pb.Order{}
+	for _, record := range s.orders {
+		if record.order.KitchenId != req.KitchenId || !within(record.createdAt) {
+			continue
+		}
+		stats.TotalOrders++
+		counted[record.order.Id] = record.order
+	}
+	// Revenue is the money taken: captured payments, and cash-on-delivery ones
+	// once the order is delivered, less their refunds.
+	for _, record := range s.payments {
+		p := record.payment
+		order, ok := counted[p.OrderId]
+		if !ok {
+			continue
+		}
+		switch {
+		case p.Status == payment.StatusCaptured, p.Status == payment.StatusPartiallyRefunded, p.Status == payment.StatusRefunded,
+			p.Status == payment.StatusAuthorized && p.Provider == payment.ProviderCashOnDelivery && order.Status == orderstatus.Delivered:
+			stats.GrossRevenue += p.Amount
+			stats.RefundedAmount += p.RefundedAmount
+		}
+	}
+	stats.GrossRevenue = pricing.Round(stats.GrossRevenue)
+	stats.RefundedAmount = pricing.Round(stats.RefundedAmount)
+	stats.TotalRevenue = pricing.Round(stats.GrossRevenue - stats.RefundedAmount)
+
+	var sum float64
+	var count int
+	for _, record := range s.reviews {
+		createdAt, _ := time.Parse(time.RFC3339, record.review.CreatedAt)
+		if record.review.KitchenId == req.KitchenId && within(createdAt) {
+			sum += float64(record.review.Rating)
+			count++
+		}
+	}
+	if count > 0 {
+		stats.AverageRating = float32(sum / float64(count))
+	}
+	return stats, nil
+}
+
+func (s *OrderStore) GetUserActivity(ctx context.Context, req *pb.GetUserActivityRequest) (*pb.GetUserActivityResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	records := s.sortedOrders(func(order *pb.Order) bool { return order.UserId == req.UserId })
+
+	resp := &pb.GetUserActivityResponse{}
+	for _, record := range records {
+		activity := &pb.UserActivity{
+			OrderId:   record.order.Id,
+			Amount:    record.order.TotalAmount,
+			Status:    record.order.Status,
+			CreatedAt: record.order.CreatedAt,
+		}
+		if kitchen, ok := s.kitchens[record.order.KitchenId]; ok {
+			activity.KitchenName = kitchen.Name
+		}
+		resp.UserActivity = append(resp.UserActivity, activity)
+	}
+	return resp, nil
+}
@@ -0,0 +1,397 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/policy"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"Github.com/LocalEats/Order-Service/internal/service"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	_ service.OrderStore = (*OrderStore)(nil)
+	_ service.OrderStore = (*repository.OrderRepository)(nil)
+	_ policy.Ownership   = (*OrderStore)(nil)
+)
+
+const (
+	kitchenID = "kitchen-1"
+	customer  = "user-1"
+)
+
+func newStore(t *testing.T) (*OrderStore, *payment.Fake) {
+	t.Helper()
+	fake := payment.NewFake()
+	store := NewOrderStore(pricing.Fees{DeliveryFee: 2, TaxRate: 0.1}, fake, pagetoken.New([]byte("test")))
+	store.PutKitchen(Kitchen{ID: kitchenID, OwnerID: "chef-1", Name: "Mama's"})
+	return store, fake
+}
+
+func createDish(t *testing.T, store *OrderStore, name string, price float64) *pb.Dish {
+	t.Helper()
+	resp, err := store.CreateDish(context.Background(), &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: kitchenID, Name: name, Price: price, Available: true}})
+	if err != nil {
+		t.Fatalf("CreateDish: %v", err)
+	}
+	return resp.Dish
+}
+
+func createOrder(t *testing.T, store *OrderStore, dishID string, quantity int32) *pb.Order {
+	t.Helper()
+	resp, err := store.CreateOrder(context.Background(), &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId:    customer,
+		KitchenId: kitchenID,
+		Items:     []*pb.OrderItem{{DishId: dishID, Quantity: quantity}},
+	}})
+	if err != nil {
+		t.Fatalf("CreateOrder: %v", err)
+	}
+	return resp.Order
+}
+
+func pay(t *testing.T, store *OrderStore, fake *payment.Fake, order *pb.Order) *pb.Payment {
+	t.Helper()
+	card, err := fake.Tokenize(context.Background(), "4242424242424242")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := store.CreatePayment(context.Background(), &pb.CreatePaymentRequest{Payment: &pb.Payment{
+		OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: "card", CardToken: card.Token,
+	}})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	return resp.Payment
+}
+
+func TestDeletedDishesAreHidden(t *testing.T) {
+	store, _ := newStore(t)
+	ctx := context.Background()
+
+	soup := createDish(t, store, "Soup", 4)
+	createDish(t, store, "Bread", 1.5)
+
+	if _, err := store.DeleteDish(ctx, &pb.DeleteDishRequest{DishId: soup.Id}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := store.GetDishes(ctx, &pb.ListDishesRequest{KitchenId: kitchenID, Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 1 || len(list.Dishes) != 1 || list.Dishes[0].Name != "Bread" {
+		t.Fatalf("listed %d of %d dishes: %v", len(list.Dishes), list.Total, list.Dishes)
+	}
+	if _, err := store.DeleteDish(ctx, &pb.DeleteDishRequest{DishId: soup.Id}); !errors.Is(err, repository.ErrDishNotFound) {
+		t.Fatalf("deleting twice: got %v", err)
+	}
+	if _, err := store.DishKitchen(ctx, soup.Id); err == nil {
+		t.Fatal("DishKitchen found a deleted dish")
+	}
+	_, err = store.CreateOrder(ctx, &pb.CreateOrderRequest{Order: &pb.Order{UserId: customer, KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: soup.Id, Quantity: 1}}}})
+	if !errors.Is(err, repository.ErrDishNotFound) {
+		t.Fatalf("ordering a deleted dish: got %v", err)
+	}
+}
+
+func TestListDishesFiltersAndSorts(t *testing.T) {
+	store, _ := newStore(t)
+	ctx := context.Background()
+	store.PutKitchen(Kitchen{ID: "kitchen-2", OwnerID: "chef-2", Name: "Spice"})
+
+	dish := func(kitchen, name string, price float64, available bool, allergens, dietary []string) *pb.Dish {
+		resp, err := store.CreateDish(ctx, &pb.CreateDishRequest{Dish: &pb.Dish{
+			KitchenId: kitchen, Name: name, Price: price, Category: "Main", Available: available, Allergens: allergens, DietaryInfo: dietary,
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Dish
+	}
+	soup := dish(kitchenID, "Soup", 4, true, []string{"Celery"}, []string{"Vegan", "gluten-free"})
+	bread := dish(kitchenID, "Bread", 2, true, []string{"gluten"}, []string{"vegan"})
+	dish(kitchenID, "Pie", 6, false, nil, nil)
+	dish("kitchen-2", "Curry", 8, true, []string{"peanuts"}, []string{"halal"})
+	createOrder(t, store, soup.Id, 3)
+	createOrder(t, store, bread.Id, 1)
+
+	names := func(req *pb.ListDishesRequest) []string {
+		t.Helper()
+		resp, err := store.GetDishes(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, dish := range resp.Dishes {
+			names = append(names, dish.Name)
+		}
+		return names
+	}
+
+	for _, tc := range []struct {
+		name string
+		req  *pb.ListDishesRequest
+		want string
+	}{
+		{"whole catalogue", &pb.ListDishesRequest{}, "Soup Bread Pie Curry"},
+		{"excluded allergens ignore case", &pb.ListDishesRequest{ExcludeAllergens: []string{" CELERY ", "Peanuts"}}, "Bread Pie"},
+		{"dietary tags must all match", &pb.ListDishesRequest{DietaryInfo: []string{"VEGAN", "gluten-free"}}, "Soup"},
+		{"kitchen and availability", &pb.ListDishesRequest{KitchenId: kitchenID, AvailableOnly: true, Category: "main"}, "Soup Bread"},
+		{"price range", &pb.ListDishesRequest{MinPrice: 2, MaxPrice: 6, SortBy: repository.DishSortPriceDesc}, "Pie Soup Bread"},
+		{"newest", &pb.ListDishesRequest{SortBy: repository.DishSortNewest}, "Curry Pie Bread Soup"},
+	} {
+		if got := strings.Join(names(tc.req), " "); got != tc.want {
+			t.Errorf("%s: got %s, want %s", tc.name, got, tc.want)
+		}
+	}
+
+	// Pages of a sorted listing follow its sort key; unordered dishes tie on
+	// popularity and fall back to newest first.
+	var got []string
+	req := &pb.ListDishesRequest{SortBy: repository.DishSortPopularity, Limit: 1}
+	for {
+		resp, err := store.GetDishes(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, resp.Dishes[0].Name)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req = &pb.ListDishesRequest{SortBy: repository.DishSortPopularity, Limit: 1, PageToken: resp.NextPageToken}
+	}
+	if strings.Join(got, " ") != "Soup Bread Curry Pie" {
+		t.Fatalf("popularity pages = %v", got)
+	}
+
+	if _, err := store.GetDishes(ctx, &pb.ListDishesRequest{SortBy: "cheapest"}); !errors.Is(err, repository.ErrUnknownDishSort) {
+		t.Fatalf("unknown sort: %v", err)
+	}
+}
+
+func TestListOrdersFiltersAndPaginates(t *testing.T) {
+	store, _ := newStore(t)
+	ctx := context.Background()
+	dish := createDish(t, store, "Soup", 4)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, createOrder(t, store, dish.Id, 1).Id)
+	}
+	if _, err := store.UpdateOrderStatus(ctx, &pb.UpdateOrderStatusRequest{OrderId: ids[0], Status: orderstatus.Rejected}, "chef-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	page, err := store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Status: orderstatus.Pending, Page: 2, Limit: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Total != 4 || len(page.Orders) != 1 {
+		t.Fatalf("got %d orders of %d, want 1 of 4", len(page.Orders), page.Total)
+	}
+	if page.Orders[0].Id != ids[1] {
+		t.Fatalf("last page holds %s, want the oldest pending order %s", page.Orders[0].Id, ids[1])
+	}
+}
+
+func TestPageTokensDoNotSkipOrders(t *testing.T) {
+	store, _ := newStore(t)
+	ctx := context.Background()
+	dish := createDish(t, store, "Soup", 4)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, createOrder(t, store, dish.Id, 1).Id)
+	}
+
+	first, err := store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Page: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.NextPageToken == "" || first.Orders[1].Id != ids[3] {
+		t.Fatalf("first page = %v, next %q", first.Orders, first.NextPageToken)
+	}
+
+	// An order placed between fetches shifts offsets but not the keyset.
+	createOrder(t, store, dish.Id, 1)
+
+	second, err := store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Limit: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Page != 2 || second.Total != 6 || len(second.Orders) != 2 || second.Orders[0].Id != ids[2] || second.Orders[1].Id != ids[1] {
+		t.Fatalf("second page %d = %v", second.Page, second.Orders)
+	}
+
+	last, err := store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Limit: 2, PageToken: second.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Page != 3 || len(last.Orders) != 1 || last.Orders[0].Id != ids[0] || last.NextPageToken != "" {
+		t.Fatalf("last page %d = %v, next %q", last.Page, last.Orders, last.NextPageToken)
+	}
+
+	_, err = store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Status: orderstatus.Pending, Limit: 2, PageToken: first.NextPageToken})
+	if !errors.Is(err, pagetoken.ErrInvalid) {
+		t.Fatalf("token reused with another filter: %v", err)
+	}
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	store, fake := newStore(t)
+	ctx := context.Background()
+	dish := createDish(t, store, "Soup", 10)
+	order := createOrder(t, store, dish.Id, 2)
+
+	if order.TotalAmount != 24 {
+		t.Fatalf("total = %.2f, want 24 (20 + 2 tax + 2 delivery)", order.TotalAmount)
+	}
+
+	accept := &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Accepted}
+	if _, err := store.UpdateOrderStatus(ctx, accept, "chef-1"); !errors.Is(err, repository.ErrPaymentRequired) {
+		t.Fatalf("accepting an unpaid order: got %v", err)
+	}
+
+	cash := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: payment.MethodCash}}
+	if _, err := store.CreatePayment(ctx, cash); !errors.Is(err, payment.ErrMethodNotServed) {
+		t.Fatalf("paying cash to a card processor: got %v", err)
+	}
+	short := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: 20, PaymentMethod: payment.MethodCard}}
+	if _, err := store.CreatePayment(ctx, short); !errors.Is(err, repository.ErrAmountNotOutstanding) {
+		t.Fatalf("paying less than the total: got %v", err)
+	}
+
+	paid := pay(t, store, fake, order)
+	if paid.Status != payment.StatusCaptured {
+		t.Fatalf("payment is %s", paid.Status)
+	}
+	again := &pb.CreatePaymentRequest{Payment: &pb.Payment{OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: payment.MethodCard}}
+	if _, err := store.CreatePayment(ctx, again); !errors.Is(err, repository.ErrOrderPaid) {
+		t.Fatalf("paying twice: got %v", err)
+	}
+	if _, err := store.UpdateOrderStatus(ctx, accept, "chef-1"); err != nil {
+		t.Fatal(err)
+	}
+
+	cancel := &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: orderstatus.Cancelled, Reason: "closing early"}
+	if _, err := store.UpdateOrderStatus(ctx, cancel, "chef-1"); !errors.Is(err, repository.ErrCancelViaStatus) {
+		t.Fatalf("cancelling through UpdateOrderStatus: got %v", err)
+	}
+	if current, _ := store.GetOrderByID(ctx, order.Id); current.Status != orderstatus.Accepted {
+		t.Fatalf("order is %s after a rejected cancellation", current.Status)
+	}
+
+	refund, err := store.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: dish.Id, Quantity: 1}, "chef-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refund.Payment.Status != payment.StatusPartiallyRefunded || refund.Refund.Amount != 11 {
+		t.Fatalf("refund left payment %s after refunding %.2f", refund.Payment.Status, refund.Refund.Amount)
+	}
+
+	cancelled, err := store.CancelOrder(ctx, order.Id, customer, "", []string{orderstatus.Pending, orderstatus.Accepted})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.Order.Status != orderstatus.Cancelled || len(cancelled.Refunds) != 1 || cancelled.Refunds[0].Status != payment.StatusRefunded {
+		t.Fatalf("cancel returned %v", cancelled)
+	}
+
+	record, err := store.GetPayment(ctx, paid.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Refunds) != 2 || record.RefundedAmount != record.Amount {
+		t.Fatalf("payment has %d refunds, %.2f of %.2f refunded", len(record.Refunds), record.RefundedAmount, record.Amount)
+	}
+	// The refunds leave the whole total unpaid, but a cancelled order is not
+	// charged again.
+	_, err = store.CreatePayment(ctx, again)
+	if !errors.Is(err, repository.ErrOrderNotPayable) || apperr.Status(err).Code() != codes.FailedPrecondition {
+		t.Fatalf("paying a cancelled order: got %v", err)
+	}
+
+	history, err := store.GetOrder(ctx, &pb.GetOrderRequest{KitchenID: kitchenID, Page: 1, Limit: 10, IncludeHistory: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(history.Order[0].StatusHistory); n != 3 {
+		t.Fatalf("status history has %d entries, want 3", n)
+	}
+}
+
+func TestKitchenStatistics(t *testing.T) {
+	store, fake := newStore(t)
+	ctx := context.Background()
+	dish := createDish(t, store, "Soup", 10)
+
+	// One order is paid and partly refunded, one paid and then cancelled,
+	// and one never paid.
+	kept := createOrder(t, store, dish.Id, 2)
+	paid := pay(t, store, fake, kept)
+	if _, err := store.RefundPayment(ctx, &pb.RefundPaymentRequest{PaymentId: paid.Id, DishId: dish.Id, Quantity: 1}, "chef-1"); err != nil {
+		t.Fatal(err)
+	}
+	cancelled := createOrder(t, store, dish.Id, 1)
+	pay(t, store, fake, cancelled)
+	if _, err := store.CancelOrder(ctx, cancelled.Id, customer, "", []string{orderstatus.Pending}); err != nil {
+		t.Fatal(err)
+	}
+	createOrder(t, store, dish.Id, 3)
+
+	stats, err := store.GetKitchenStatistics(ctx, &pb.GetKitchenStatisticsRequest{KitchenId: kitchenID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.GetKitchenStatisticsResponse{
+		TotalOrders:    3,
+		GrossRevenue:   kept.TotalAmount + cancelled.TotalAmount,
+		RefundedAmount: 11 + cancelled.TotalAmount,
+		TotalRevenue:   kept.TotalAmount - 11,
+	}
+	if stats.TotalOrders != want.TotalOrders || !pricing.Equal(stats.GrossRevenue, want.GrossRevenue) ||
+		!pricing.Equal(stats.RefundedAmount, want.RefundedAmount) || !pricing.Equal(stats.TotalRevenue, want.TotalRevenue) {
+		t.Fatalf("statistics = %v, want %v", stats, want)
+	}
+}
+
+func TestConcurrentOrders(t *testing.T) {
+	store, fake := newStore(t)
+	ctx := context.Background()
+	dish := createDish(t, store, "Soup", 5)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			order := createOrder(t, store, dish.Id, 1)
+			pay(t, store, fake, order)
+			store.ListOrders(ctx, &pb.ListOrdersRequest{KitchenId: kitchenID, Page: 1, Limit: 10})
+		}()
+	}
+	wg.Wait()
+
+	orders, err := store.ListOrders(ctx, &pb.ListOrdersRequest{Page: 1, Limit: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payments, err := store.ListPayments(ctx, &pb.ListPaymentsRequest{UserId: customer, Page: 1, Limit: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orders.Total != 50 || payments.Total != 50 {
+		t.Fatalf("got %d orders and %d payments, want 50 of each", orders.Total, payments.Total)
+	}
+}
@@ -0,0 +1,338 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"github.com/google/uuid"
+)
+
+type paymentRecord struct {
+	seq     int
+	payment *pb.Payment
+}
+
+func (s *OrderStore) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	if err := repository.ValidatePayment(req.Payment); err != nil {
+		return nil, err
+	}
+	if !payment.Serves(s.Payments, req.Payment.PaymentMethod) {
+		return nil, payment.ErrMethodNotServed
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	live, err := s.liveOrder(req.Payment.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if live.order.Status != orderstatus.Pending {
+		return nil, fmt.Errorf("%w: order is %s", repository.ErrOrderNotPayable, live.order.Status)
+	}
+	due := s.unpaidBalance(live.order)
+	if due <= 0 {
+		return nil, repository.ErrOrderPaid
+	}
+	if !pricing.Equal(req.Payment.Amount, due) {
+		return nil, fmt.Errorf("%w: %.2f is outstanding", repository.ErrAmountNotOutstanding, due)
+	}
+
+	provider := s.Payments
+	now := timestamp(s.now())
+	record := &pb.Payment{
+		Id:            uuid.NewString(),
+		OrderId:       req.Payment.OrderId,
+		Amount:        pricing.Round(req.Payment.Amount),
+		Status:        payment.StatusPending,
+		PaymentMethod: req.Payment.PaymentMethod,
+		Provider:      provider.Name(),
+		CardToken:     req.Payment.CardToken,
+		CardLast4:     req.Payment.CardLast4,
+		CardBrand:     req.Payment.CardBrand,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	s.payments[record.Id] = &paymentRecord{seq: s.next(), payment: record}
+
+	txID, err := provider.Authorize(ctx, payment.AuthorizeRequest{
+		OrderID: req.Payment.OrderId,
+		Amount:  req.Payment.Amount,
+		Method:  req.Payment.PaymentMethod,
+		Card:    payment.Card{Token: req.Payment.CardToken, Last4: req.Payment.CardLast4, Brand: req.Payment.CardBrand},
+	})
+	if err != nil {
+		s.setPaymentStatus(record, payment.StatusFailed, "")
+		return nil, err
+	}
+	s.setPaymentStatus(record, payment.StatusAuthorized, txID)
+
+	if provider.Name() != payment.ProviderCashOnDelivery {
+		if err = provider.Capture(ctx, txID, req.Payment.Amount); err != nil {
+			provider.Void(ctx, txID)
+			s.setPaymentStatus(record, payment.StatusFailed, "")
+			return nil, err
+		}
+		s.setPaymentStatus(record, payment.StatusCaptured, txID)
+	}
+	return &pb.CreatePaymentResponse{Payment: clone(record)}, nil
+}
+
+func (s *OrderStore) setPaymentStatus(record *pb.Payment, status, transactionID string) {
+	record.Status = status
+	if transactionID != "" {
+		record.TransactionId = transactionID
+	}
+	record.UpdatedAt = timestamp(s.now())
+}
+
+func (s *OrderStore) GetPayment(ctx context.Context, id string) (*pb.Payment, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	record, ok := s.payments[id]
+	if !ok {
+		return nil, repository.ErrPaymentNotFound
+	}
+	view := clone(record.payment)
+	for _, refund := range s.refunds {
+		if refund.PaymentId == id {
+			view.Refunds = append(view.Refunds, clone(refund))
+		}
+	}
+	return view, nil
+}
+
+func (s *OrderStore) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var records []*paymentRecord
+	for _, record := range s.payments {
+		p := record.payment
+		order := s.orders[p.OrderId].order
+		if (req.OrderId == "" || p.OrderId == req.OrderId) &&
+			(req.UserId == "" || order.UserId == req.UserId) &&
+			(req.KitchenId == "" || order.KitchenId == req.KitchenId) &&
+			(req.Status == "" || p.Status == req.Status) {
+			records = append(records, record)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].seq > records[j].seq })
+
+	resp := &pb.ListPaymentsResponse{Total: int32(len(records)), Page: req.Page, Limit: req.Limit}
+	for _, record := range paginate(records, req.Page, req.Limit) {
+		resp.Payments = append(resp.Payments, clone(record.payment))
+	}
+	return resp, nil
+}
+
+// outstandingBalance is what is left to pay on the order: its total minus
+// captured payments and authorized cash-on-delivery ones, net of refunds.
+func (s *OrderStore) outstandingBalance(order *pb.Order) float64 {
+	var paid float64
+	for _, record := range s.payments {
+		p := record.payment
+		if p.OrderId != order.Id {
+			continue
+		}
+		if p.Status == payment.StatusCaptured || (p.Status == payment.StatusAuthorized && p.Provider == payment.ProviderCashOnDelivery) {
+			paid += p.Amount - p.RefundedAmount
+		}
+	}
+	return pricing.Round(order.TotalAmount - paid)
+}
+
+// unpaidBalance is what a new payment may still cover: the order's total minus
+// every payment that has not failed or been voided, net of refunds.
+func (s *OrderStore) unpaidBalance(order *pb.Order) float64 {
+	var paid float64
+	for _, record := range s.payments {
+		p := record.payment
+		if p.OrderId != order.Id {
+			continue
+		}
+		switch p.Status {
+		case payment.StatusPending, payment.StatusAuthorized, payment.StatusCaptured, payment.StatusPartiallyRefunded:
+			paid += p.Amount - p.RefundedAmount
+		}
+	}
+	return pricing.Round(order.TotalAmount - paid)
+}
+
+// requirePayment fails unless the order's captured payments, plus authorized
+// cash-on-delivery ones, cover its total. Refunded amounts do not count.
+func (s *OrderStore) requirePayment(order *pb.Order) error {
+	if s.outstandingBalance(order) > 0 {
+		return repository.ErrPaymentRequired
+	}
+	return nil
+}
+
+// refundOrderPayments refunds what is left of captured payments and voids
+// authorized ones. The store is only changed once every provider call has
+// succeeded.
+func (s *OrderStore) refundOrderPayments(ctx context.Context, orderID, actorID, reason string) ([]*pb.Payment, error) {
+	var outstanding []*paymentRecord
+	for _, record := range s.payments {
+		p := record.payment
+		if p.OrderId != orderID || p.Amount <= p.RefundedAmount {
+			continue
+		}
+		switch p.Status {
+		case payment.StatusAuthorized, payment.StatusCaptured, payment.StatusPartiallyRefunded:
+			outstanding = append(outstanding, record)
+		}
+	}
+	sort.Slice(outstanding, func(i, j int) bool { return outstanding[i].seq < outstanding[j].seq })
+
+	type settled struct {
+		record   *pb.Payment
+		state    string
+		refundID string
+		amount   float64
+	}
+	var changes []settled
+	for _, record := range outstanding {
+		p := record.payment
+		if p.Provider != s.Payments.Name() {
+			return nil, fmt.Errorf("%w: %s used %q, not %q", repository.ErrProviderMismatch, p.Id, p.Provider, s.Payments.Name())
+		}
+
+		change := settled{record: p, state: payment.StatusRefunded, amount: pricing.Round(p.Amount - p.RefundedAmount)}
+		var err error
+		if p.Status == payment.StatusAuthorized {
+			change.state = payment.StatusVoided
+			err = s.Payments.Void(ctx, p.TransactionId)
+		} else {
+			change.refundID, err = s.Payments.Refund(ctx, p.TransactionId, change.amount)
+		}
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+
+	var refunds []*pb.Payment
+	for _, change := range changes {
+		if change.refundID != "" {
+			s.insertRefund(&pb.Refund{PaymentId: change.record.Id, OrderId: orderID, Amount: change.amount, Reason: reason, ProviderRefundId: change.refundID, ActorId: actorID})
+		}
+		change.record.Status = change.state
+		change.record.RefundedAmount = change.record.Amount
+		change.record.RefundTransactionId = change.refundID
+		change.record.UpdatedAt = timestamp(s.now())
+		refunds = append(refunds, clone(change.record))
+	}
+	return refunds, nil
+}
+
+func (s *OrderStore) insertRefund(refund *pb.Refund) *pb.Refund {
+	stored := clone(refund)
+	stored.Id = uuid.NewString()
+	stored.CreatedAt = timestamp(s.now())
+	s.refunds = append(s.refunds, stored)
+	return clone(stored)
+}
+
+func (s *OrderStore) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest, actorID string) (*pb.RefundPaymentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.payments[req.PaymentId]
+	if !ok {
+		return nil, repository.ErrPaymentNotFound
+	}
+	p := record.payment
+	if p.Status != payment.StatusCaptured && p.Status != payment.StatusPartiallyRefunded {
+		return nil, fmt.Errorf("%w: payment is %s", repository.ErrPaymentNotRefundable, p.Status)
+	}
+	if p.Provider != s.Payments.Name() {
+		return nil, fmt.Errorf("%w: %s used %q, not %q", repository.ErrProviderMismatch, req.PaymentId, p.Provider, s.Payments.Name())
+	}
+
+	amount, quantity := req.Amount, req.Quantity
+	if req.DishId != "" {
+		var err error
+		amount, quantity, err = s.lineItemRefund(p.OrderId, req.DishId, quantity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	amount = pricing.Round(amount)
+	remaining := pricing.Round(p.Amount - p.RefundedAmount)
+	if amount <= 0 {
+		return nil, payment.ErrInvalidAmount
+	}
+	if amount > remaining {
+		return nil, fmt.Errorf("%w: %.2f left", repository.ErrRefundExceeded, remaining)
+	}
+
+	refundID, err := s.Payments.Refund(ctx, p.TransactionId, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := s.insertRefund(&pb.Refund{
+		PaymentId:        req.PaymentId,
+		OrderId:          p.OrderId,
+		Amount:           amount,
+		DishId:           req.DishId,
+		Quantity:         quantity,
+		Reason:           req.Reason,
+		ProviderRefundId: refundID,
+		ActorId:          actorID,
+	})
+
+	p.RefundedAmount = pricing.Round(p.RefundedAmount + amount)
+	p.Status = payment.StatusPartiallyRefunded
+	if p.RefundedAmount >= p.Amount {
+		p.Status = payment.StatusRefunded
+	}
+	p.RefundTransactionId = refundID
+	p.UpdatedAt = timestamp(s.now())
+
+	return &pb.RefundPaymentResponse{Payment: clone(p), Refund: refund}, nil
+}
+
+// lineItemRefund prices a refund of quantity units of the dish, or all units
+// not yet refunded when quantity is 0. An order may list the dish on several
+// lines, which are refunded as one. Each unit is refunded at what the customer
+// paid for it, after the order's discount and with its tax.
+func (s *OrderStore) lineItemRefund(orderID, dishID string, quantity int32) (float64, int32, error) {
+	order := s.orders[orderID].order
+
+	var gross float64
+	var ordered int32
+	for _, item := range order.Items {
+		if item.DishId == dishID {
+			gross += item.Price * float64(item.Quantity)
+			ordered += item.Quantity
+		}
+	}
+	if ordered == 0 {
+		return 0, 0, repository.ErrItemNotInOrder
+	}
+
+	left := ordered
+	for _, refund := range s.refunds {
+		if refund.OrderId == orderID && refund.DishId == dishID {
+			left -= refund.Quantity
+		}
+	}
+
+	if quantity == 0 {
+		quantity = left
+	}
+	if quantity <= 0 || quantity > left {
+		return 0, 0, fmt.Errorf("%w: %d of the dish left to refund", repository.ErrRefundExceeded, left)
+	}
+	breakdown := pricing.Breakdown{Subtotal: order.PriceBreakdown.Subtotal, Discount: order.PriceBreakdown.Discount, Tax: order.PriceBreakdown.Tax}
+	return pricing.ItemRefund(breakdown, gross*float64(quantity)/float64(ordered)), quantity, nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	"Github.com/LocalEats/Order-Service/internal/notify"
+	"Github.com/LocalEats/Order-Service/internal/policy"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"Github.com/LocalEats/Order-Service/internal/token"
+	pq "github.com/lib/pq"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	minPasswordLength = 8
+	passwordResetTTL  = time.Hour
+)
+
+var userTypes = map[string]bool{"customer": true, userTypeChef: true}
+
+type AuthService struct {
+	UserRepo    *repository.UserRepository
+	KitchenRepo *repository.KitchenRepository
+	Tokens      *token.Manager
+	Policy      *policy.KitchenPolicy
+	Notifier    notify.Notifier
+	pb.UnimplementedAuthServiceServer
+}
+
+func NewAuthService(userRepo *repository.UserRepository, kitchenRepo *repository.KitchenRepository, tokens *token.Manager, kitchenPolicy *policy.KitchenPolicy, notifier notify.Notifier) *AuthService {
+	return &AuthService{
+		UserRepo:    userRepo,
+		KitchenRepo: kitchenRepo,
+		Tokens:      tokens,
+		Policy:      kitchenPolicy,
+		Notifier:    notifier,
+	}
+}
+
+func (s *AuthService) Register(ctx context.Context, req *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	req.Username = strings.TrimSpace(req.Username)
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	if req.UserType == "" {
+		req.UserType = "customer"
+	}
+
+	if req.Username == "" {
+		return nil, status.Error(codes.InvalidArgument, "username is required")
+	}
+	if _, err := mail.ParseAddress(req.Email); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "email is invalid")
+	}
+	if len(req.Password) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+	if !userTypes[req.UserType] {
+		return nil, status.Error(codes.InvalidArgument, "user_type must be customer or chef")
+	}
+
+	usernameTaken, emailTaken, err := s.UserRepo.UsernameOrEmailTaken(ctx, req.Username, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if usernameTaken {
+		return nil, status.Error(codes.AlreadyExists, "username is already taken")
+	}
+	if emailTaken {
+		return nil, status.Error(codes.AlreadyExists, "email is already registered")
+	}
+
+	passwordHash, err := hashPassword(req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.CreateUser(ctx, req, passwordHash)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, status.Error(codes.AlreadyExists, "username or email is already registered")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterResponse{User: user}, nil
+}
+
+func (s *AuthService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	user, passwordHash, err := s.UserRepo.GetUserByEmail(ctx, strings.TrimSpace(req.Email))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !checkPassword(passwordHash, req.Password) {
+		return nil, status.Error(codes.Unauthenticated, "invalid email or password")
+	}
+
+	pair, err := s.Tokens.Issue(user.Id, user.UserType)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LoginResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+	}, nil
+}
+
+func (s *AuthService) GetProfile(ctx context.Context, req *pb.GetProfileRequest) (*pb.GetProfileResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.GetUserByID(ctx, caller.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProfileResponse{User: user}, nil
+}
+
+func (s *AuthService) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepo.UpdateProfile(ctx, caller.UserID, req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateProfileResponse{User: user}, nil
+}
+
+// ResetPassword sends the user a one-time reset token, to be redeemed with
+// ConfirmPasswordReset. It always answers with the same message so callers
+// cannot probe which emails are registered.
+func (s *AuthService) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	resp := &pb.ResetPasswordResponse{Message: "If the email is registered, a password reset has been requested"}
+
+	user, _, err := s.UserRepo.GetUserByEmail(ctx, strings.TrimSpace(req.Email))
+	if errors.Is(err, sql.ErrNoRows) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	resetToken := hex.EncodeToString(buf)
+	expiresAt := time.Now().Add(passwordResetTTL)
+	if err := s.UserRepo.CreatePasswordReset(ctx, user.Id, hashResetToken(resetToken), expiresAt); err != nil {
+		return nil, err
+	}
+	if err := s.Notifier.PasswordReset(ctx, user.Email, resetToken, expiresAt); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ConfirmPasswordReset sets a new password with a token sent by
+// ResetPassword. Each token works once, and only until it expires.
+func (s *AuthService) ConfirmPasswordReset(ctx context.Context, req *pb.ConfirmPasswordResetRequest) (*pb.ConfirmPasswordResetResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if len(req.NewPassword) < minPasswordLength {
+		return nil, status.Errorf(codes.InvalidArgument, "password must be at least %d characters", minPasswordLength)
+	}
+
+	passwordHash, err := hashPassword(req.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.UserRepo.ConsumePasswordReset(ctx, hashResetToken(req.Token), passwordHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.InvalidArgument, "reset token is invalid, expired or already used")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfirmPasswordResetResponse{Message: "Password has been reset"}, nil
+}
+
+// hashResetToken is what password_resets stores in place of the raw token.
+func hashResetToken(resetToken string) string {
+	sum := sha256.Sum256([]byte(resetToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	pair, err := s.Tokens.Refresh(ctx, req.RefreshToken)
+	if errors.Is(err, token.ErrInvalidToken) || errors.Is(err, token.ErrTokenRevoked) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{
+		AccessToken:  pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+		ExpiresIn:    pair.ExpiresIn,
+	}, nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Tokens.RevokeSession(ctx, caller.Claims); err != nil {
+		return nil, err
+	}
+	return &pb.LogoutResponse{Message: "Successfully logged out"}, nil
+}
+
+func hashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedPassword), nil
+}
+
+func checkPassword(hashedPassword, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil
+}
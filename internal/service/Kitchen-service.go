@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	userTypeChef = "chef"
+
+	defaultPageLimit = 10
+	maxPageLimit     = 100
+)
+
+func (s *AuthService) CreateKitchen(ctx context.Context, req *pb.CreateKitchenRequest) (*pb.CreateKitchenResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if caller.UserType != userTypeChef {
+		return nil, status.Error(codes.PermissionDenied, "only chefs can create kitchens")
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if err := validateKitchenFees(req.DeliveryFee, req.TaxRate); err != nil {
+		return nil, err
+	}
+
+	kitchen, err := s.KitchenRepo.CreateKitchen(ctx, caller.UserID, req)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateKitchenResponse{Kitchen: kitchen}, nil
+}
+
+func (s *AuthService) UpdateKitchen(ctx context.Context, req *pb.UpdateKitchenRequest) (*pb.UpdateKitchenResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Policy.AuthorizeKitchen(ctx, caller, req.KitchenId); err != nil {
+		return nil, err
+	}
+	if err := validateKitchenFees(req.DeliveryFee, req.TaxRate); err != nil {
+		return nil, err
+	}
+
+	kitchen, err := s.KitchenRepo.UpdateKitchen(ctx, req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "kitchen not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateKitchenResponse{Kitchen: kitchen}, nil
+}
+
+func (s *AuthService) GetKitchen(ctx context.Context, req *pb.GetKitchenRequest) (*pb.GetKitchenResponse, error) {
+	kitchen, err := s.KitchenRepo.GetKitchen(ctx, req.KitchenId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "kitchen not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetKitchenResponse{Kitchen: kitchen}, nil
+}
+
+func (s *AuthService) ListKitchens(ctx context.Context, req *pb.ListKitchensRequest) (*pb.ListKitchensResponse, error) {
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
+	return s.KitchenRepo.ListKitchens(ctx, req)
+}
+
+// validateKitchenFees checks the optional per-kitchen pricing overrides.
+func validateKitchenFees(deliveryFee, taxRate *float64) error {
+	if deliveryFee != nil && *deliveryFee < 0 {
+		return status.Error(codes.InvalidArgument, "delivery_fee must not be negative")
+	}
+	if taxRate != nil && (*taxRate < 0 || *taxRate >= 1) {
+		return status.Error(codes.InvalidArgument, "tax_rate must be a fraction between 0 and 1")
+	}
+	return nil
+}
+
+// pageAndLimit applies the default page size and caps it at maxPageLimit.
+func pageAndLimit(page, limit int32) (int32, int32) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	return page, limit
+}
+
+func (s *AuthService) SearchKitchens(ctx context.Context, req *pb.SearchKitchensRequest) (*pb.SearchKitchensResponse, error) {
+	req.Query = strings.TrimSpace(req.Query)
+	req.CuisineType = strings.TrimSpace(req.CuisineType)
+	if req.Rating < 0 || req.Rating > 5 {
+		return nil, status.Error(codes.InvalidArgument, "rating must be between 0 and 5")
+	}
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
+	return s.KitchenRepo.SearchKitchens(ctx, req)
+}
@@ -2,80 +2,376 @@ package service
 
 import (
 	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/policy"
 	"Github.com/LocalEats/Order-Service/internal/repository"
 	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
 )
 
+// OrderStore is the storage behind OrderService. repository.OrderRepository
+// implements it on Postgres and memory.OrderStore in process memory.
+type OrderStore interface {
+	CreateDish(ctx context.Context, req *pb.CreateDishRequest) (*pb.CreateDishResponse, error)
+	UpdateDish(ctx context.Context, req *pb.UpdateDishRequest) (*pb.UpdateDishResponse, error)
+	DeleteDish(ctx context.Context, req *pb.DeleteDishRequest) (*pb.DeleteDishResponse, error)
+	GetDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error)
+	UpdateDishNutritionInfo(ctx context.Context, req *pb.UpdateDishNutritionInfoRequest) (*pb.UpdateDishNutritionInfoResponse, error)
+
+	CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error)
+	GetOrderByID(ctx context.Context, id string) (*pb.Order, error)
+	GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error)
+	ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error)
+	UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest, actorID string) (*pb.UpdateOrderStatusResponse, error)
+	CancelOrder(ctx context.Context, orderID, actorID, reason string, allowedFrom []string) (*pb.CancelOrderResponse, error)
+
+	CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error)
+	ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error)
+
+	CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error)
+	GetPayment(ctx context.Context, id string) (*pb.Payment, error)
+	ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error)
+	RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest, actorID string) (*pb.RefundPaymentResponse, error)
+
+	GetDishRecommendations(ctx context.Context, req *pb.GetDishRecommendationsRequest) (*pb.GetDishRecommendationsResponse, error)
+	GetKitchenStatistics(ctx context.Context, req *pb.GetKitchenStatisticsRequest) (*pb.GetKitchenStatisticsResponse, error)
+	GetUserActivity(ctx context.Context, req *pb.GetUserActivityRequest) (*pb.GetUserActivityResponse, error)
+	UpdateWorkingHours(ctx context.Context, req *pb.UpdateWorkingHoursRequest) (*pb.UpdateWorkingHoursResponse, error)
+}
+
 type OrderService struct {
-	OrderRepo *repository.OrderRepository
+	OrderRepo OrderStore
+	Policy    *policy.KitchenPolicy
 	pb.UnimplementedOrderServiceServer
 }
 
-func NewOrderService(orderRepo repository.OrderRepository) *OrderService {
+func NewOrderService(orderRepo OrderStore, kitchenPolicy *policy.KitchenPolicy) *OrderService {
 	return &OrderService{
-		OrderRepo: &orderRepo,
+		OrderRepo: orderRepo,
+		Policy:    kitchenPolicy,
 	}
 }
 func (s *OrderService) CreateDish(ctx context.Context, req *pb.CreateDishRequest) (*pb.CreateDishResponse, error) {
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.CreateDish(ctx, req)
 }
 
 func (s *OrderService) UpdateDish(ctx context.Context, req *pb.UpdateDishRequest) (*pb.UpdateDishResponse, error) {
+	if req.Dish == nil {
+		return nil, apperr.Invalid("dish", "dish is required")
+	}
+	if req.DishId == "" {
+		req.DishId = req.Dish.Id
+	}
+	req.Dish.Id = req.DishId
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.UpdateDish(ctx, req)
 }
 
 func (s *OrderService) DeleteDish(ctx context.Context, req *pb.DeleteDishRequest) (*pb.DeleteDishResponse, error) {
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.DeleteDish(ctx, req)
 }
 
 func (s *OrderService) CreateOrder(ctx context.Context, req *pb.CreateOrderRequest) (*pb.CreateOrderResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Order == nil {
+		return nil, apperr.Invalid("order", "order is required")
+	}
+	req.Order.UserId = caller.UserID
+
 	return s.OrderRepo.CreateOrder(ctx, req)
 }
 
+// GetOrder lists a kitchen's orders for its owner or an admin.
 func (s *OrderService) GetOrder(ctx context.Context, req *pb.GetOrderRequest) (*pb.GetOrderResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Policy.AuthorizeKitchen(ctx, caller, req.KitchenID); err != nil {
+		return nil, err
+	}
+
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
 	return s.OrderRepo.GetOrder(ctx, req)
 }
 
-func (s *OrderService) GetDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
+// CancelOrder lets the customer cancel an order until the kitchen starts
+// preparing it, and the kitchen (or an admin) cancel it with a reason until it
+// goes out for delivery. Successful payments for the order are refunded.
+func (s *OrderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.CancelOrderResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.OrderRepo.GetOrderByID(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedFrom := []string{orderstatus.Pending, orderstatus.Accepted}
+	if order.UserId != caller.UserID {
+		if err := s.Policy.AuthorizeKitchen(ctx, caller, order.KitchenId); err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(req.Reason) == "" {
+			return nil, apperr.Invalid("reason", "reason is required when the kitchen cancels an order")
+		}
+		allowedFrom = append(allowedFrom, orderstatus.Preparing, orderstatus.Ready)
+	}
+
+	return s.OrderRepo.CancelOrder(ctx, req.OrderId, caller.UserID, strings.TrimSpace(req.Reason), allowedFrom)
+}
+
+// ListDishes browses the catalogue, optionally narrowed to one kitchen.
+func (s *OrderService) ListDishes(ctx context.Context, req *pb.ListDishesRequest) (*pb.ListDishesResponse, error) {
+	if req.MaxPrice != 0 && req.MaxPrice < req.MinPrice {
+		return nil, apperr.Invalid("max_price", "max_price must not be below min_price")
+	}
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
 	return s.OrderRepo.GetDishes(ctx, req)
 }
 
+// UpdateOrderStatus lets the kitchen that received the order, or an admin,
+// move it along the order lifecycle. Cancellations go through CancelOrder.
 func (s *OrderService) UpdateOrderStatus(ctx context.Context, req *pb.UpdateOrderStatusRequest) (*pb.UpdateOrderStatusResponse, error) {
-	return s.OrderRepo.UpdateOrderStatus(ctx, req)
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !orderstatus.Valid(req.Status) {
+		return nil, apperr.Invalid("status", fmt.Sprintf("unknown order status %q", req.Status))
+	}
+	if req.Status == orderstatus.Cancelled {
+		return nil, repository.ErrCancelViaStatus
+	}
+
+	order, err := s.OrderRepo.GetOrderByID(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Policy.AuthorizeKitchen(ctx, caller, order.KitchenId); err != nil {
+		return nil, err
+	}
+
+	return s.OrderRepo.UpdateOrderStatus(ctx, req, caller.UserID)
 }
 
+// ListOrders lists the caller's own orders, or a kitchen's orders for its
+// owner. Admins may filter freely.
 func (s *OrderService) ListOrders(ctx context.Context, req *pb.ListOrdersRequest) (*pb.ListOrdersResponse, error) {
-	return s.ListOrders(ctx, req)
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !caller.IsAdmin() {
+		if req.KitchenId != "" {
+			if err := s.Policy.AuthorizeKitchen(ctx, caller, req.KitchenId); err != nil {
+				return nil, err
+			}
+		} else {
+			req.UserId = caller.UserID
+		}
+	}
+
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
+	return s.OrderRepo.ListOrders(ctx, req)
 }
 
 func (s *OrderService) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.CreateReviewResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Review == nil {
+		return nil, apperr.Invalid("review", "review is required")
+	}
+	req.Review.UserId = caller.UserID
+
 	return s.OrderRepo.CreateReview(ctx, req)
 }
 
 func (s *OrderService) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
-	return s.OrderRepo.ListReviews(ctx, "ali", req)
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
+	return s.OrderRepo.ListReviews(ctx, req)
 }
 
+// CreatePayment lets the customer who placed the order, or an admin, pay its
+// outstanding balance.
 func (s *OrderService) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := repository.ValidatePayment(req.Payment); err != nil {
+		return nil, err
+	}
+
+	order, err := s.OrderRepo.GetOrderByID(ctx, req.Payment.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserId != caller.UserID && !caller.IsAdmin() {
+		return nil, apperr.New(apperr.Forbidden, "only the customer who placed the order can pay for it")
+	}
+
 	return s.OrderRepo.CreatePayment(ctx, req)
 }
 
+// GetPayment returns a payment to the customer who placed the order, the
+// kitchen that received it or an admin.
+func (s *OrderService) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*pb.GetPaymentResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.OrderRepo.GetPayment(ctx, req.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.OrderRepo.GetOrderByID(ctx, record.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserId != caller.UserID {
+		if err := s.Policy.AuthorizeKitchen(ctx, caller, order.KitchenId); err != nil {
+			return nil, err
+		}
+	}
+	return &pb.GetPaymentResponse{Payment: record}, nil
+}
+
+// ListPayments lists the caller's own payments, or a kitchen's payments for
+// its owner. Admins may filter freely.
+func (s *OrderService) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !caller.IsAdmin() {
+		if req.KitchenId != "" {
+			if err := s.Policy.AuthorizeKitchen(ctx, caller, req.KitchenId); err != nil {
+				return nil, err
+			}
+		} else {
+			req.UserId = caller.UserID
+		}
+	}
+
+	req.Page, req.Limit = pageAndLimit(req.Page, req.Limit)
+	return s.OrderRepo.ListPayments(ctx, req)
+}
+
+// RefundPayment lets the kitchen that received the order, or an admin, refund
+// a line item or an amount of a captured payment.
+func (s *OrderService) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if (req.DishId == "") == (req.Amount == 0) {
+		return nil, &apperr.Error{Kind: apperr.Validation, Message: "exactly one of dish_id and amount is required", Violations: []apperr.FieldViolation{
+			{Field: "dish_id", Description: "exactly one of dish_id and amount is required"},
+			{Field: "amount", Description: "exactly one of dish_id and amount is required"},
+		}}
+	}
+	if req.Amount < 0 || req.Quantity < 0 {
+		return nil, apperr.New(apperr.Validation, "amount and quantity must not be negative")
+	}
+
+	record, err := s.OrderRepo.GetPayment(ctx, req.PaymentId)
+	if err != nil {
+		return nil, err
+	}
+	order, err := s.OrderRepo.GetOrderByID(ctx, record.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Policy.AuthorizeKitchen(ctx, caller, order.KitchenId); err != nil {
+		return nil, err
+	}
+
+	return s.OrderRepo.RefundPayment(ctx, req, caller.UserID)
+}
+
 func (s *OrderService) GetDishRecommendations(ctx context.Context, req *pb.GetDishRecommendationsRequest) (*pb.GetDishRecommendationsResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.IsAdmin() {
+		req.UserId = caller.UserID
+	}
 	return s.OrderRepo.GetDishRecommendations(ctx, req)
 }
 
-func (s *OrderService) GetKitchenStatics(ctx context.Context, req *pb.GetKitchenStatisticsRequest) (*pb.GetKitchenStatisticsResponse, error) {
+func (s *OrderService) GetKitchenStatistics(ctx context.Context, req *pb.GetKitchenStatisticsRequest) (*pb.GetKitchenStatisticsResponse, error) {
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.GetKitchenStatistics(ctx, req)
 }
 
 func (s *OrderService) GetUserActivity(ctx context.Context, req *pb.GetUserActivityRequest) (*pb.GetUserActivityResponse, error) {
+	caller, err := principal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.IsAdmin() {
+		req.UserId = caller.UserID
+	}
 	return s.OrderRepo.GetUserActivity(ctx, req)
 }
 
 func (s *OrderService) UpdateWorkingHours(ctx context.Context, req *pb.UpdateWorkingHoursRequest) (*pb.UpdateWorkingHoursResponse, error) {
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.UpdateWorkingHours(ctx, req)
 }
 
 func (s *OrderService) UpdateDishNutritionInfo(ctx context.Context, req *pb.UpdateDishNutritionInfoRequest) (*pb.UpdateDishNutritionInfoResponse, error) {
+	if err := s.authorizeKitchen(ctx, req); err != nil {
+		return nil, err
+	}
 	return s.OrderRepo.UpdateDishNutritionInfo(ctx, req)
 }
+
+// authorizeKitchen rejects kitchen-scoped requests from callers who neither own
+// the kitchen nor are admins.
+func (s *OrderService) authorizeKitchen(ctx context.Context, req interface{}) error {
+	caller, err := principal(ctx)
+	if err != nil {
+		return err
+	}
+	return s.Policy.Authorize(ctx, caller, req)
+}
+
+// principal returns the caller authenticated by auth.UnaryInterceptor.
+func principal(ctx context.Context) (*auth.Principal, error) {
+	p, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	return p, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"Github.com/LocalEats/Order-Service/internal/auth"
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/pagetoken"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/policy"
+	"Github.com/LocalEats/Order-Service/internal/pricing"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+	"Github.com/LocalEats/Order-Service/internal/repository/memory"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	kitchenID = "kitchen-1"
+	chef      = "chef-1"
+	customer  = "user-1"
+)
+
+func newService(t *testing.T) (*OrderService, *memory.OrderStore, *payment.Fake) {
+	t.Helper()
+	fake := payment.NewFake()
+	store := memory.NewOrderStore(pricing.Fees{DeliveryFee: 2, TaxRate: 0.1}, fake, pagetoken.New([]byte("test")))
+	store.PutKitchen(memory.Kitchen{ID: kitchenID, OwnerID: chef, Name: "Mama's"})
+	return NewOrderService(store, policy.NewKitchenPolicy(store)), store, fake
+}
+
+func as(userID, userType string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Principal{UserID: userID, UserType: userType})
+}
+
+func placeOrder(t *testing.T, store *memory.OrderStore, userID string) *pb.Order {
+	t.Helper()
+	dish, err := store.CreateDish(context.Background(), &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: kitchenID, Name: "Soup", Price: 10, Available: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := store.CreateOrder(context.Background(), &pb.CreateOrderRequest{Order: &pb.Order{
+		UserId: userID, KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: dish.Dish.Id, Quantity: 1}},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.Order
+}
+
+func TestOrdersAreScopedToTheCaller(t *testing.T) {
+	svc, store, _ := newService(t)
+	mine := placeOrder(t, store, customer)
+	placeOrder(t, store, "user-2")
+
+	list, err := svc.ListOrders(as(customer, "customer"), &pb.ListOrdersRequest{UserId: "user-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Orders) != 1 || list.Orders[0].Id != mine.Id {
+		t.Fatalf("customer listed %v", list.Orders)
+	}
+
+	if _, err := svc.ListOrders(as(customer, "customer"), &pb.ListOrdersRequest{KitchenId: kitchenID}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("customer listing the kitchen's orders: got %v", err)
+	}
+	if _, err := svc.GetOrder(as(customer, "customer"), &pb.GetOrderRequest{KitchenID: kitchenID}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("customer getting the kitchen's orders: got %v", err)
+	}
+
+	for _, caller := range []context.Context{as(chef, "chef"), as("admin-1", auth.UserTypeAdmin)} {
+		kitchen, err := svc.ListOrders(caller, &pb.ListOrdersRequest{KitchenId: kitchenID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(kitchen.Orders) != 2 {
+			t.Fatalf("listed %d kitchen orders, want 2", len(kitchen.Orders))
+		}
+		got, err := svc.GetOrder(caller, &pb.GetOrderRequest{KitchenID: kitchenID})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.Order) != 2 {
+			t.Fatalf("got %d kitchen orders, want 2", len(got.Order))
+		}
+	}
+}
+
+// pay has the customer pay for order with a card from the fake processor.
+func pay(t *testing.T, svc *OrderService, fake *payment.Fake, order *pb.Order) *pb.Payment {
+	t.Helper()
+	card, err := fake.Tokenize(context.Background(), "4242424242424242")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := svc.CreatePayment(as(order.UserId, "customer"), &pb.CreatePaymentRequest{Payment: &pb.Payment{
+		OrderId: order.Id, Amount: order.TotalAmount, PaymentMethod: payment.MethodCard, CardToken: card.Token,
+	}})
+	if err != nil {
+		t.Fatalf("CreatePayment: %v", err)
+	}
+	return resp.Payment
+}
+
+func TestCancelOrder(t *testing.T) {
+	svc, store, fake := newService(t)
+	advance := func(order *pb.Order, to string) {
+		t.Helper()
+		if _, err := svc.UpdateOrderStatus(as(chef, "chef"), &pb.UpdateOrderStatusRequest{OrderId: order.Id, Status: to}); err != nil {
+			t.Fatalf("moving order to %s: %v", to, err)
+		}
+	}
+
+	// The customer may cancel until the kitchen starts cooking, and is refunded.
+	accepted := placeOrder(t, store, customer)
+	paid := pay(t, svc, fake, accepted)
+	advance(accepted, orderstatus.Accepted)
+	if _, err := svc.CancelOrder(as("user-2", "customer"), &pb.CancelOrderRequest{OrderId: accepted.Id}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("another customer cancelling: got %v", err)
+	}
+	cancelled, err := svc.CancelOrder(as(customer, "customer"), &pb.CancelOrderRequest{OrderId: accepted.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.Order.Status != orderstatus.Cancelled || len(cancelled.Refunds) != 1 || cancelled.Refunds[0].Status != payment.StatusRefunded {
+		t.Fatalf("cancel returned %v", cancelled)
+	}
+	record, err := store.GetPayment(context.Background(), paid.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(record.Refunds) != 1 || record.RefundedAmount != record.Amount {
+		t.Fatalf("payment has %d refunds, %.2f of %.2f refunded", len(record.Refunds), record.RefundedAmount, record.Amount)
+	}
+
+	// Once it is being prepared only the kitchen can cancel, and must say why.
+	preparing := placeOrder(t, store, customer)
+	pay(t, svc, fake, preparing)
+	advance(preparing, orderstatus.Accepted)
+	advance(preparing, orderstatus.Preparing)
+	if _, err := svc.CancelOrder(as(customer, "customer"), &pb.CancelOrderRequest{OrderId: preparing.Id}); !errors.Is(err, repository.ErrCancellationWindow) {
+		t.Fatalf("customer cancelling while preparing: got %v", err)
+	}
+	if _, err := svc.CancelOrder(as(chef, "chef"), &pb.CancelOrderRequest{OrderId: preparing.Id, Reason: "  "}); apperr.Status(err).Code() != codes.InvalidArgument {
+		t.Fatalf("kitchen cancelling without a reason: got %v", err)
+	}
+	cancelled, err = svc.CancelOrder(as(chef, "chef"), &pb.CancelOrderRequest{OrderId: preparing.Id, Reason: "out of stock"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cancelled.Order.Status != orderstatus.Cancelled || len(cancelled.Refunds) != 1 || cancelled.Refunds[0].Status != payment.StatusRefunded {
+		t.Fatalf("cancel returned %v", cancelled)
+	}
+
+	// Delivered orders cannot be cancelled by anyone.
+	delivered := placeOrder(t, store, customer)
+	pay(t, svc, fake, delivered)
+	for _, to := range []string{orderstatus.Accepted, orderstatus.Preparing, orderstatus.Ready, orderstatus.OutForDelivery, orderstatus.Delivered} {
+		advance(delivered, to)
+	}
+	if _, err := svc.CancelOrder(as(chef, "chef"), &pb.CancelOrderRequest{OrderId: delivered.Id, Reason: "mistake"}); !errors.Is(err, repository.ErrCancellationWindow) {
+		t.Fatalf("cancelling a delivered order: got %v", err)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	config "Github.com/LocalEats/Order-Service/internal/config"
 
 	_ "github.com/lib/pq"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func ConnectDB(config config.Config) (*sql.DB, error) {
@@ -34,11 +33,3 @@ func ConnectDB(config config.Config) (*sql.DB, error) {
 
 	return db, nil
 }
-
-func hashPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
-	return string(hashedPassword), nil
-}
@@ -0,0 +1,230 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"Github.com/LocalEats/Order-Service/internal/pgtest"
+)
+
+// The Migrator tests run against their own schema; see pgtest for how the
+// Postgres server is found.
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
+
+func file(body string) *fstest.MapFile {
+	return &fstest.MapFile{Data: []byte(body)}
+}
+
+// threeTables creates tables a, b and c, one migration each.
+var threeTables = fstest.MapFS{
+	"000001_a.up.sql":   file(`create table a (id int)`),
+	"000001_a.down.sql": file(`drop table a`),
+	"000002_b.up.sql":   file(`create table b (id int)`),
+	"000002_b.down.sql": file(`drop table b`),
+	"000003_c.up.sql":   file(`create table c (id int)`),
+	"000003_c.down.sql": file(`drop table c`),
+	"README.md":         file(`not a migration`),
+}
+
+func newMigrator(t *testing.T, fsys fstest.MapFS) *Migrator {
+	t.Helper()
+	migrator, err := NewMigrator(pgtest.Open(t), fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return migrator
+}
+
+func tableExists(t *testing.T, db *sql.DB, name string) bool {
+	t.Helper()
+	var exists bool
+	err := db.QueryRow(`select exists (
+		select 1 from information_schema.tables where table_schema = current_schema() and table_name = $1
+	)`, name).Scan(&exists)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return exists
+}
+
+func checkStatus(t *testing.T, migrator *Migrator, version uint, dirty bool, pending int) {
+	t.Helper()
+	status, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Version != version || status.Dirty != dirty || len(status.Pending) != pending {
+		t.Fatalf("status = version %d, dirty %v, %d pending; want version %d, dirty %v, %d pending",
+			status.Version, status.Dirty, len(status.Pending), version, dirty, pending)
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := LoadMigrations(threeTables)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 3 {
+		t.Fatalf("loaded %d migrations, want 3", len(migrations))
+	}
+	for i, m := range migrations {
+		if m.Version != uint(i+1) || m.Up == "" || m.Down == "" {
+			t.Errorf("migration %d = %+v", i, m)
+		}
+	}
+
+	broken := map[string]fstest.MapFS{
+		"no up file": {
+			"000001_a.down.sql": file(`drop table a`),
+		},
+		"conflicting names": {
+			"000001_a.up.sql":   file(`create table a (id int)`),
+			"000001_b.down.sql": file(`drop table b`),
+		},
+	}
+	for name, fsys := range broken {
+		if _, err := LoadMigrations(fsys); err == nil {
+			t.Errorf("%s: loaded without an error", name)
+		}
+	}
+}
+
+func TestUpAndDown(t *testing.T) {
+	ctx := context.Background()
+	migrator := newMigrator(t, threeTables)
+	checkStatus(t, migrator, 0, false, 3)
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(applied) != "[1 2 3]" {
+		t.Fatalf("applied %v, want [1 2 3]", applied)
+	}
+	checkStatus(t, migrator, 3, false, 0)
+	if applied, err := migrator.Up(ctx); err != nil || len(applied) != 0 {
+		t.Fatalf("second Up applied %v: %v", applied, err)
+	}
+
+	reverted, err := migrator.Down(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(reverted) != "[3 2]" {
+		t.Fatalf("reverted %v, want [3 2]", reverted)
+	}
+	checkStatus(t, migrator, 1, false, 2)
+	if !tableExists(t, migrator.DB, "a") || tableExists(t, migrator.DB, "b") || tableExists(t, migrator.DB, "c") {
+		t.Fatal("Down did not drop exactly b and c")
+	}
+
+	// Rolling back past the first migration empties schema_migrations.
+	if reverted, err := migrator.Down(ctx, 5); err != nil || fmt.Sprint(reverted) != "[1]" {
+		t.Fatalf("reverted %v: %v", reverted, err)
+	}
+	checkStatus(t, migrator, 0, false, 3)
+	if tableExists(t, migrator.DB, "a") {
+		t.Fatal("table a survived rolling everything back")
+	}
+}
+
+func TestFailedMigrationLeavesTheVersionDirty(t *testing.T) {
+	ctx := context.Background()
+	migrator := newMigrator(t, fstest.MapFS{
+		"000001_a.up.sql":   file(`create table a (id int)`),
+		"000001_a.down.sql": file(`drop table a`),
+		"000002_b.up.sql":   file(`create table b (id int); select * from missing`),
+		"000002_b.down.sql": file(`drop table b`),
+		"000003_c.up.sql":   file(`create table c (id int)`),
+	})
+
+	applied, err := migrator.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "migration 2_b") {
+		t.Fatalf("Up = %v, want migration 2 to fail", err)
+	}
+	if fmt.Sprint(applied) != "[1]" {
+		t.Fatalf("applied %v before the failure, want [1]", applied)
+	}
+	// The failed step is rolled back but its version stays marked dirty.
+	checkStatus(t, migrator, 2, true, 1)
+	if tableExists(t, migrator.DB, "b") {
+		t.Fatal("the failed migration's table was kept")
+	}
+
+	if _, err := migrator.Up(ctx); err == nil || !strings.Contains(err.Error(), "dirty") {
+		t.Fatalf("Up on a dirty database: %v", err)
+	}
+	if _, err := migrator.Down(ctx, 1); err == nil || !strings.Contains(err.Error(), "dirty") {
+		t.Fatalf("Down on a dirty database: %v", err)
+	}
+
+	if err := migrator.Force(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	checkStatus(t, migrator, 1, false, 2)
+}
+
+func TestMigrationsWaitForTheAdvisoryLock(t *testing.T) {
+	ctx := context.Background()
+	migrator := newMigrator(t, threeTables)
+
+	holder, err := migrator.DB.Conn(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer holder.Close()
+	if _, err := holder.ExecContext(ctx, `select pg_advisory_lock($1)`, migrationLockID); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := migrator.Up(ctx)
+		done <- err
+	}()
+
+	// Wait until Up is queued on the lock, then check it has not run.
+	waiting := false
+	for deadline := time.Now().Add(10 * time.Second); !waiting && time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		err := migrator.DB.QueryRow(`select exists (
+			select 1 from pg_locks
+			where locktype = 'advisory' and not granted and (classid::bigint << 32 | objid::bigint) = $1
+		)`, migrationLockID).Scan(&waiting)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !waiting {
+		t.Fatal("Up never waited for the advisory lock")
+	}
+	select {
+	case err := <-done:
+		t.Fatalf("Up finished while another session held the lock: %v", err)
+	default:
+	}
+	if tableExists(t, migrator.DB, "a") {
+		t.Fatal("Up migrated while another session held the lock")
+	}
+
+	if _, err := holder.ExecContext(ctx, `select pg_advisory_unlock($1)`, migrationLockID); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Up did not finish after the lock was released")
+	}
+	checkStatus(t, migrator, 3, false, 0)
+}
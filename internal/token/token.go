@@ -0,0 +1,199 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	KindAccess  = "access"
+	KindRefresh = "refresh"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrTokenRevoked = errors.New("token has been revoked")
+)
+
+// Claims is the payload of both access and refresh tokens. Every token issued
+// from one login shares a SessionID so the whole session can be revoked at once.
+type Claims struct {
+	UserType  string `json:"user_type"`
+	SessionID string `json:"sid"`
+	Kind      string `json:"kind"`
+	jwt.RegisteredClaims
+}
+
+// RevocationStore is the persisted denylist of token and session ids.
+type RevocationStore interface {
+	// Revoke adds id to the denylist until expiresAt. It reports false when
+	// the id was already revoked.
+	Revoke(ctx context.Context, id string, expiresAt time.Time) (bool, error)
+	IsRevoked(ctx context.Context, ids ...string) (bool, error)
+}
+
+type Pair struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int32
+}
+
+type Manager struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	revoked    RevocationStore
+	now        func() time.Time
+}
+
+func NewManager(privateKey ed25519.PrivateKey, accessTTL, refreshTTL time.Duration, revoked RevocationStore) *Manager {
+	return &Manager{
+		privateKey: privateKey,
+		publicKey:  privateKey.Public().(ed25519.PublicKey),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		revoked:    revoked,
+		now:        time.Now,
+	}
+}
+
+// LoadPrivateKey reads a PKCS#8 PEM encoded Ed25519 key, as produced by
+// `openssl genpkey -algorithm ed25519`.
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM data found", path)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an ed25519 private key", path)
+	}
+	return privateKey, nil
+}
+
+// Issue starts a new session for the user and returns its first token pair.
+func (m *Manager) Issue(userID, userType string) (*Pair, error) {
+	return m.issue(userID, userType, uuid.NewString())
+}
+
+func (m *Manager) issue(userID, userType, sessionID string) (*Pair, error) {
+	now := m.now()
+
+	access, err := m.sign(userID, userType, sessionID, KindAccess, now, m.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := m.sign(userID, userType, sessionID, KindRefresh, now, m.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pair{
+		AccessToken:  access,
+		RefreshToken: refresh,
+		ExpiresIn:    int32(m.accessTTL.Seconds()),
+	}, nil
+}
+
+func (m *Manager) sign(userID, userType, sessionID, kind string, now time.Time, ttl time.Duration) (string, error) {
+	claims := Claims{
+		UserType:  userType,
+		SessionID: sessionID,
+		Kind:      kind,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   userID,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(m.privateKey)
+}
+
+func (m *Manager) parse(tokenString, kind string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return m.publicKey, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}), jwt.WithTimeFunc(m.now), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if claims.Kind != kind || claims.Subject == "" || claims.ID == "" || claims.SessionID == "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// ParseAccess validates an access token and checks that neither it nor its
+// session has been revoked.
+func (m *Manager) ParseAccess(ctx context.Context, tokenString string) (*Claims, error) {
+	claims, err := m.parse(tokenString, KindAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := m.revoked.IsRevoked(ctx, claims.ID, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+	return claims, nil
+}
+
+// Refresh consumes a refresh token and returns a new pair for the same session.
+// Refresh tokens are single-use: presenting one a second time is treated as
+// theft and revokes the whole session.
+func (m *Manager) Refresh(ctx context.Context, refreshToken string) (*Pair, error) {
+	claims, err := m.parse(refreshToken, KindRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := m.revoked.IsRevoked(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+
+	first, err := m.revoked.Revoke(ctx, claims.ID, claims.ExpiresAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	if !first {
+		if _, err := m.revoked.Revoke(ctx, claims.SessionID, m.now().Add(m.refreshTTL)); err != nil {
+			return nil, err
+		}
+		return nil, ErrTokenRevoked
+	}
+
+	return m.issue(claims.Subject, claims.UserType, claims.SessionID)
+}
+
+// RevokeSession invalidates every access and refresh token of the session.
+func (m *Manager) RevokeSession(ctx context.Context, claims *Claims) error {
+	_, err := m.revoked.Revoke(ctx, claims.SessionID, m.now().Add(m.refreshTTL))
+	return err
+}
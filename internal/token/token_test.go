@@ -0,0 +1,183 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	mu  sync.Mutex
+	ids map[string]time.Time
+}
+
+func (s *memoryStore) Revoke(_ context.Context, id string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.ids[id]; ok {
+		return false, nil
+	}
+	s.ids[id] = expiresAt
+	return true, nil
+}
+
+func (s *memoryStore) IsRevoked(_ context.Context, ids ...string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, id := range ids {
+		if _, ok := s.ids[id]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newTestManager(t *testing.T) (*Manager, *time.Time) {
+	t.Helper()
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	m := NewManager(privateKey, 15*time.Minute, 24*time.Hour, &memoryStore{ids: map[string]time.Time{}})
+	m.now = func() time.Time { return now }
+	return m, &now
+}
+
+func TestIssueAndParseAccess(t *testing.T) {
+	m, _ := newTestManager(t)
+	ctx := context.Background()
+
+	pair, err := m.Issue("user-1", "chef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pair.ExpiresIn != 900 {
+		t.Errorf("ExpiresIn = %d, want 900", pair.ExpiresIn)
+	}
+
+	claims, err := m.ParseAccess(ctx, pair.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "user-1" || claims.UserType != "chef" {
+		t.Errorf("claims = %+v", claims)
+	}
+
+	if _, err := m.ParseAccess(ctx, pair.RefreshToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("refresh token accepted as access token: %v", err)
+	}
+}
+
+func TestParseAccessRejectsExpiredAndForeignTokens(t *testing.T) {
+	m, now := newTestManager(t)
+	ctx := context.Background()
+
+	pair, err := m.Issue("user-1", "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, _ := newTestManager(t)
+	if _, err := other.ParseAccess(ctx, pair.AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("token signed with another key accepted: %v", err)
+	}
+
+	*now = now.Add(16 * time.Minute)
+	if _, err := m.ParseAccess(ctx, pair.AccessToken); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("expired token accepted: %v", err)
+	}
+}
+
+func TestRefreshRotatesAndDetectsReuse(t *testing.T) {
+	m, _ := newTestManager(t)
+	ctx := context.Background()
+
+	first, err := m.Issue("user-1", "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := m.Refresh(ctx, first.RefreshToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.RefreshToken == first.RefreshToken {
+		t.Fatal("refresh token was not rotated")
+	}
+
+	if _, err := m.Refresh(ctx, first.RefreshToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Fatalf("reused refresh token: err = %v, want ErrTokenRevoked", err)
+	}
+
+	// Reuse revokes the whole session, including tokens issued after rotation.
+	if _, err := m.Refresh(ctx, second.RefreshToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("rotated refresh token after reuse: err = %v", err)
+	}
+	if _, err := m.ParseAccess(ctx, second.AccessToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("access token after reuse: err = %v", err)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	m, _ := newTestManager(t)
+	ctx := context.Background()
+
+	pair, err := m.Issue("user-1", "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := m.Issue("user-1", "customer")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := m.ParseAccess(ctx, pair.AccessToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.RevokeSession(ctx, claims); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.ParseAccess(ctx, pair.AccessToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("access token after logout: err = %v", err)
+	}
+	if _, err := m.Refresh(ctx, pair.RefreshToken); !errors.Is(err, ErrTokenRevoked) {
+		t.Errorf("refresh token after logout: err = %v", err)
+	}
+	if _, err := m.ParseAccess(ctx, other.AccessToken); err != nil {
+		t.Errorf("other session was revoked too: %v", err)
+	}
+}
+
+func TestLoadPrivateKey(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwt.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadPrivateKey(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Equal(privateKey) {
+		t.Error("loaded key differs from the written key")
+	}
+}
@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"Github.com/LocalEats/Order-Service/internal/orderstatus"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"Github.com/LocalEats/Order-Service/internal/repository"
+)
+
+const (
+	// maxLimit is the largest page size a client may ask for. A limit of 0
+	// picks the default page size.
+	maxLimit = 100
+	maxPage  = 100000
+
+	maxPrice    = 100000
+	maxQuantity = 100
+	maxAmount   = 1000000
+)
+
+var orderStatuses = []string{
+	orderstatus.Pending, orderstatus.Accepted, orderstatus.Preparing, orderstatus.Ready,
+	orderstatus.OutForDelivery, orderstatus.Delivered, orderstatus.Cancelled, orderstatus.Rejected,
+}
+
+var paymentStatuses = []string{
+	payment.StatusPending, payment.StatusAuthorized, payment.StatusCaptured, payment.StatusFailed,
+	payment.StatusRefunded, payment.StatusPartiallyRefunded, payment.StatusVoided,
+}
+
+func pagination(page, limit string) []Rule {
+	return []Rule{Range(page, 0, maxPage), Range(limit, 0, maxLimit)}
+}
+
+// pageToken bounds the opaque token of keyset-paginated listings. The
+// repository verifies its signature.
+var pageToken = Length("page_token", 1, 512)
+
+func dishFields(prefix string) []Rule {
+	return []Rule{
+		Length(prefix+"name", 1, 100),
+		Length(prefix+"description", 0, 1000),
+		Range(prefix+"price", 0.01, maxPrice),
+		Length(prefix+"category", 0, 50),
+		Items(prefix+"ingredients", 0, 50),
+		Length(prefix+"ingredients", 1, 100),
+		Items(prefix+"allergens", 0, 30),
+		Length(prefix+"allergens", 1, 50),
+		Items(prefix+"dietary_info", 0, 30),
+		Length(prefix+"dietary_info", 1, 50),
+	}
+}
+
+func rules(groups ...[]Rule) []Rule {
+	var all []Rule
+	for _, group := range groups {
+		all = append(all, group...)
+	}
+	return all
+}
+
+// OrderRules covers every OrderService request. User ids that the service
+// takes from the caller's token are checked only when set.
+var OrderRules = Rules{
+	"order.CreateDishRequest": rules(
+		[]Rule{Required("dish"), Required("dish.kitchen_id"), UUID("dish.kitchen_id"), Required("dish.name")},
+		dishFields("dish."),
+	),
+	"order.UpdateDishRequest": rules(
+		[]Rule{UUID("dish_id"), Required("dish"), UUID("dish.id"), Required("dish.name")},
+		dishFields("dish."),
+	),
+	"order.DeleteDishRequest": {Required("dish_id"), UUID("dish_id")},
+	"order.ListDishesRequest": rules(
+		[]Rule{
+			UUID("kitchen_id"), pageToken,
+			Length("category", 0, 50),
+			Range("min_price", 0, maxPrice), Range("max_price", 0, maxPrice),
+			Items("dietary_info", 0, 10), Length("dietary_info", 1, 50),
+			Items("exclude_allergens", 0, 30), Length("exclude_allergens", 1, 50),
+			OneOf("sort_by", repository.DishSorts...),
+		},
+		pagination("page", "limit"),
+	),
+
+	"order.CreateOrderRequest": {
+		Required("order"),
+		UUID("order.user_id"),
+		Required("order.kitchen_id"), UUID("order.kitchen_id"),
+		Items("order.items", 1, 50),
+		Required("order.items.dish_id"), UUID("order.items.dish_id"),
+		Range("order.items.quantity", 1, maxQuantity),
+		Range("order.total_amount", 0, maxAmount),
+		Length("order.delivery_address", 0, 500),
+		Timestamp("order.delivery_time"),
+		Length("order.discount_code", 0, 50),
+	},
+	"order.UpdateOrderStatusRequest": {
+		Required("order_id"), UUID("order_id"),
+		Required("status"), OneOf("status", orderStatuses...),
+		Length("reason", 0, 500),
+	},
+	"order.ListOrdersRequest": rules(
+		[]Rule{UUID("user_id"), UUID("kitchen_id"), OneOf("status", orderStatuses...), pageToken},
+		pagination("page", "limit"),
+	),
+	"order.GetOrderRequest": rules(
+		[]Rule{UUID("KitchenID"), pageToken},
+		pagination("Page", "Limit"),
+	),
+	"order.CancelOrderRequest": {Required("order_id"), UUID("order_id"), Length("reason", 0, 500)},
+
+	"order.CreateReviewRequest": {
+		Required("review"),
+		Required("review.order_id"), UUID("review.order_id"),
+		UUID("review.user_id"),
+		Range("review.rating", 1, 5),
+		Length("review.comment", 0, 2000),
+	},
+	"order.ListReviewsRequest": rules(
+		[]Rule{Required("kitchen_id"), UUID("kitchen_id"), pageToken},
+		pagination("page", "limit"),
+	),
+
+	"order.CreatePaymentRequest": {
+		Required("payment"),
+		Required("payment.order_id"), UUID("payment.order_id"),
+		Range("payment.amount", 0.01, maxAmount),
+		Required("payment.payment_method"), Length("payment.payment_method", 1, 20),
+	},
+	"order.GetPaymentRequest": {Required("payment_id"), UUID("payment_id")},
+	"order.ListPaymentsRequest": rules(
+		[]Rule{UUID("order_id"), UUID("user_id"), UUID("kitchen_id"), OneOf("status", paymentStatuses...)},
+		pagination("page", "limit"),
+	),
+	"order.RefundPaymentRequest": {
+		Required("payment_id"), UUID("payment_id"),
+		Range("amount", 0, maxAmount),
+		UUID("dish_id"),
+		Range("quantity", 0, maxQuantity),
+		Length("reason", 0, 500),
+	},
+
+	"order.GetDishRecommendationsRequest": {UUID("user_id")},
+	"order.GetKitchenStatisticsRequest": {
+		Required("kitchen_id"), UUID("kitchen_id"),
+		Date("start_date"), Date("end_date"),
+	},
+	"order.GetUserActivityRequest": {UUID("user_id"), Date("start_date"), Date("end_date")},
+	"order.UpdateWorkingHoursRequest": {
+		Required("kitchen_id"), UUID("kitchen_id"),
+		Items("working_hours", 0, 14),
+		Range("working_hours.day_of_week", 0, 6),
+		Required("working_hours.open_time"), Clock("working_hours.open_time"),
+		Required("working_hours.close_time"), Clock("working_hours.close_time"),
+	},
+	"order.UpdateDishNutritionInfoRequest": {
+		Required("dish_id"), UUID("dish_id"),
+		Items("allergens", 0, 30), Length("allergens", 1, 50),
+		Items("dietary_info", 0, 30), Length("dietary_info", 1, 50),
+		Range("calories", 0, 10000),
+		Range("protein", 0, 1000),
+		Range("carbohydrates", 0, 1000),
+		Range("fat", 0, 1000),
+	},
+}
@@ -0,0 +1,245 @@
+// Package validate checks incoming requests against declarative per-message
+// rules. UnaryInterceptor runs every rule for the request and reports all
+// failing fields in a single InvalidArgument error.
+package validate
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Rule checks one field. Field is a dotted path of proto field names; a path
+// through a repeated message field, such as order.items.quantity, applies the
+// rule to every element. Rules other than Required and Items apply to each
+// element of repeated fields, and the string rules skip unset singular fields.
+type Rule struct {
+	Field string
+	whole bool
+	check func(v protoreflect.Value, fd protoreflect.FieldDescriptor) string
+}
+
+// Rules maps request message names to their rules.
+type Rules map[protoreflect.FullName][]Rule
+
+// Required fails for empty strings, unset messages and empty lists.
+func Required(field string) Rule {
+	return Rule{Field: field, whole: true, check: func(v protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+		switch {
+		case fd.IsList():
+			if v.List().Len() == 0 {
+				return "is required"
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			if !v.Message().IsValid() {
+				return "is required"
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			if strings.TrimSpace(v.String()) == "" {
+				return "is required"
+			}
+		}
+		return ""
+	}}
+}
+
+// Items bounds the number of elements of a repeated field.
+func Items(field string, min, max int) Rule {
+	return Rule{Field: field, whole: true, check: func(v protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+		if n := v.List().Len(); n < min || n > max {
+			return fmt.Sprintf("must have between %d and %d items", min, max)
+		}
+		return ""
+	}}
+}
+
+// UUID requires a canonical hyphenated UUID.
+func UUID(field string) Rule {
+	return stringRule(field, func(s string) string {
+		if len(s) != 36 || uuid.Validate(s) != nil {
+			return "must be a UUID"
+		}
+		return ""
+	})
+}
+
+// Length bounds the number of characters of a string. A min above zero does
+// not make a singular field required; combine it with Required for that.
+func Length(field string, min, max int) Rule {
+	return stringRule(field, func(s string) string {
+		if n := utf8.RuneCountInString(s); n < min || n > max {
+			return fmt.Sprintf("must be between %d and %d characters", min, max)
+		}
+		return ""
+	})
+}
+
+// OneOf requires one of the given values.
+func OneOf(field string, values ...string) Rule {
+	return stringRule(field, func(s string) string {
+		for _, value := range values {
+			if s == value {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(values, ", ")
+	})
+}
+
+// Timestamp requires an RFC 3339 timestamp.
+func Timestamp(field string) Rule {
+	return stringRule(field, func(s string) string {
+		if _, err := time.Parse(time.RFC3339, s); err != nil {
+			return "must be an RFC 3339 timestamp"
+		}
+		return ""
+	})
+}
+
+// Date requires a YYYY-MM-DD date or an RFC 3339 timestamp.
+func Date(field string) Rule {
+	return stringRule(field, func(s string) string {
+		if _, err := time.Parse(time.DateOnly, s); err == nil {
+			return ""
+		}
+		if _, err := time.Parse(time.RFC3339, s); err == nil {
+			return ""
+		}
+		return "must be a date (YYYY-MM-DD) or an RFC 3339 timestamp"
+	})
+}
+
+// Clock requires a 24-hour time of day such as 09:30.
+func Clock(field string) Rule {
+	return stringRule(field, func(s string) string {
+		if _, err := time.Parse("15:04", s); err != nil || len(s) != 5 {
+			return "must be a time of day (HH:MM)"
+		}
+		return ""
+	})
+}
+
+// Range bounds a number, inclusively. Unlike the other rules it also checks
+// zero values, so a min above zero makes the field required.
+func Range(field string, min, max float64) Rule {
+	return Rule{Field: field, check: func(v protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+		var n float64
+		switch fd.Kind() {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			n = v.Float()
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			n = float64(v.Int())
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			n = float64(v.Uint())
+		default:
+			return ""
+		}
+		if n < min || n > max {
+			return fmt.Sprintf("must be between %s and %s", formatNumber(min), formatNumber(max))
+		}
+		return ""
+	}}
+}
+
+func stringRule(field string, check func(string) string) Rule {
+	return Rule{Field: field, check: func(v protoreflect.Value, fd protoreflect.FieldDescriptor) string {
+		// Unset singular strings are left to Required; empty list elements
+		// are checked.
+		if fd.Kind() != protoreflect.StringKind || (v.String() == "" && !fd.IsList()) {
+			return ""
+		}
+		return check(v.String())
+	}}
+}
+
+func formatNumber(n float64) string {
+	return strconv.FormatFloat(n, 'f', -1, 64)
+}
+
+// Validate runs the rules for msg and returns every violation, in rule order.
+func (r Rules) Validate(msg proto.Message) []apperr.FieldViolation {
+	m := msg.ProtoReflect()
+	var violations []apperr.FieldViolation
+	for _, rule := range r[m.Descriptor().FullName()] {
+		walk(m, strings.Split(rule.Field, "."), "", rule, func(path string, v protoreflect.Value, fd protoreflect.FieldDescriptor) {
+			if description := rule.check(v, fd); description != "" {
+				violations = append(violations, apperr.FieldViolation{Field: path, Description: path + " " + description})
+			}
+		})
+	}
+	return violations
+}
+
+// walk calls fn for every value the rule applies to, with its indexed path
+// such as order.items[2].quantity. Unset intermediate messages are skipped;
+// a Required rule on the parent reports those.
+func walk(m protoreflect.Message, parts []string, prefix string, rule Rule, fn func(string, protoreflect.Value, protoreflect.FieldDescriptor)) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(parts[0]))
+	if fd == nil {
+		return
+	}
+	path := prefix + parts[0]
+
+	if len(parts) == 1 {
+		if rule.whole || !fd.IsList() {
+			fn(path, m.Get(fd), fd)
+			return
+		}
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			fn(fmt.Sprintf("%s[%d]", path, i), list.Get(i), fd)
+		}
+		return
+	}
+
+	if fd.Kind() != protoreflect.MessageKind || fd.IsMap() {
+		return
+	}
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			walk(list.Get(i).Message(), parts[1:], fmt.Sprintf("%s[%d].", path, i), rule, fn)
+		}
+		return
+	}
+	if m.Has(fd) {
+		walk(m.Get(fd).Message(), parts[1:], path+".", rule, fn)
+	}
+}
+
+// UnaryInterceptor rejects requests that break their rules with a single
+// InvalidArgument listing every failing field. It should run after
+// auth.UnaryInterceptor and before the idempotency interceptor, so invalid
+// requests never reserve a key.
+func UnaryInterceptor(rules Rules) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+		violations := rules.Validate(msg)
+		if len(violations) == 0 {
+			return handler(ctx, req)
+		}
+
+		descriptions := make([]string, len(violations))
+		for i, v := range violations {
+			descriptions[i] = v.Description
+		}
+		return nil, &apperr.Error{
+			Kind:       apperr.Validation,
+			Message:    "invalid request: " + strings.Join(descriptions, "; "),
+			Violations: violations,
+		}
+	}
+}
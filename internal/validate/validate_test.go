@@ -0,0 +1,129 @@
+package validate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/apperr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	kitchenID = "6f1c1c1e-5a4b-4c6e-9d7a-0b3f2a1e9c11"
+	dishID    = "0d2d8a4e-1b2c-4f6a-8e9d-3c4b5a6f7e80"
+)
+
+func TestEveryRequestHasRules(t *testing.T) {
+	methods := pb.File_order_order_proto.Services().ByName("OrderService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		input := methods.Get(i).Input()
+		rules, ok := OrderRules[input.FullName()]
+		if !ok {
+			t.Errorf("%s has no rules", input.FullName())
+			continue
+		}
+		for _, rule := range rules {
+			if !resolves(input, rule.Field) {
+				t.Errorf("%s: rule field %s does not exist", input.FullName(), rule.Field)
+			}
+		}
+	}
+}
+
+func resolves(md protoreflect.MessageDescriptor, path string) bool {
+	parts := strings.Split(path, ".")
+	for i, name := range parts {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return false
+		}
+		if i < len(parts)-1 {
+			if fd.Message() == nil {
+				return false
+			}
+			md = fd.Message()
+		}
+	}
+	return true
+}
+
+func fields(violations []apperr.FieldViolation) []string {
+	var names []string
+	for _, v := range violations {
+		names = append(names, v.Field)
+	}
+	return names
+}
+
+func TestOrderRules(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		req    proto.Message
+		fields []string
+	}{
+		{"valid dish", &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: kitchenID, Name: "Soup", Price: 4.5, Ingredients: []string{"water"}}}, nil},
+		{"missing dish", &pb.CreateDishRequest{}, []string{"dish"}},
+		{"bad dish", &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: "kitchen-1", Name: " ", Price: -1, Ingredients: []string{"water", ""}}},
+			[]string{"dish.kitchen_id", "dish.name", "dish.price", "dish.ingredients[1]"}},
+		{"zero quantity", &pb.CreateOrderRequest{Order: &pb.Order{KitchenId: kitchenID, Items: []*pb.OrderItem{{DishId: dishID, Quantity: 1}, {DishId: dishID}}}},
+			[]string{"order.items[1].quantity"}},
+		{"no items", &pb.CreateOrderRequest{Order: &pb.Order{KitchenId: kitchenID}}, []string{"order.items"}},
+		{"bad delivery time", &pb.CreateOrderRequest{Order: &pb.Order{KitchenId: kitchenID, DeliveryTime: "tomorrow", Items: []*pb.OrderItem{{DishId: "x", Quantity: 1}}}},
+			[]string{"order.items[0].dish_id", "order.delivery_time"}},
+		{"rating", &pb.CreateReviewRequest{Review: &pb.Review{OrderId: dishID, Rating: 7.5}}, []string{"review.rating"}},
+		{"half star", &pb.CreateReviewRequest{Review: &pb.Review{OrderId: dishID, Rating: 4.5}}, nil},
+		{"huge limit", &pb.ListDishesRequest{Limit: 1000, Page: -1}, []string{"page", "limit"}},
+		{"default limit", &pb.ListDishesRequest{}, nil},
+		{"dish filters", &pb.ListDishesRequest{SortBy: "cheapest", MinPrice: -1, ExcludeAllergens: []string{"peanuts", ""}},
+			[]string{"min_price", "exclude_allergens[1]", "sort_by"}},
+		{"status", &pb.UpdateOrderStatusRequest{OrderId: dishID, Status: "eaten"}, []string{"status"}},
+		{"working hours", &pb.UpdateWorkingHoursRequest{KitchenId: kitchenID, WorkingHours: []*pb.WorkingHours{{DayOfWeek: 7, OpenTime: "9:00", CloseTime: "21:00"}}},
+			[]string{"working_hours[0].day_of_week", "working_hours[0].open_time"}},
+		{"dates", &pb.GetKitchenStatisticsRequest{KitchenId: kitchenID, StartDate: "2024-01-01", EndDate: "01/31/2024"}, []string{"end_date"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fields(OrderRules.Validate(tc.req))
+			if strings.Join(got, ",") != strings.Join(tc.fields, ",") {
+				t.Fatalf("violations for %v, want %v", got, tc.fields)
+			}
+		})
+	}
+}
+
+func TestUnaryInterceptorReportsEveryField(t *testing.T) {
+	interceptor := UnaryInterceptor(OrderRules)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.OrderService_CreateDish_FullMethodName}
+	called := false
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		called = true
+		return &pb.CreateDishResponse{}, nil
+	}
+
+	_, err := interceptor(context.Background(), &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: kitchenID, Price: -2}}, info, handler)
+	if called {
+		t.Fatal("handler ran for an invalid request")
+	}
+	s := apperr.Status(err)
+	if s.Code() != codes.InvalidArgument || !strings.Contains(s.Message(), "dish.name") || !strings.Contains(s.Message(), "dish.price") {
+		t.Fatalf("got %s %q", s.Code(), s.Message())
+	}
+	var badRequest *errdetails.BadRequest
+	for _, detail := range s.Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 2 {
+		t.Fatalf("details = %v", s.Details())
+	}
+
+	if _, err := interceptor(context.Background(), &pb.CreateDishRequest{Dish: &pb.Dish{KitchenId: kitchenID, Name: "Soup", Price: 2}}, info, handler); err != nil || !called {
+		t.Fatalf("valid request: called=%v err=%v", called, err)
+	}
+}
@@ -0,0 +1,218 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	l "Github.com/LocalEats/Order-Service/internal/config/logger"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// SignatureHeader carries "t=<unix seconds>,v1=<hex HMAC-SHA256>" where the
+// MAC is computed over "<t>.<body>".
+const SignatureHeader = "X-Webhook-Signature"
+
+const (
+	EventAuthorized = "payment.authorized"
+	EventCaptured   = "payment.captured"
+	EventFailed     = "payment.failed"
+	EventRefunded   = "payment.refunded"
+)
+
+var eventStatus = map[string]string{
+	EventAuthorized: payment.StatusAuthorized,
+	EventCaptured:   payment.StatusCaptured,
+	EventFailed:     payment.StatusFailed,
+	EventRefunded:   payment.StatusRefunded,
+}
+
+var ErrPaymentNotFound = errors.New("payment not found")
+
+// Event is a payment notification from the processor.
+type Event struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	TransactionID string `json:"transaction_id"`
+	Created       int64  `json:"created"`
+}
+
+// Store is what the webhook needs from the payments storage.
+// UpdatePaymentStatus only updates a payment still in status from and reports
+// whether it did; a refund is recorded under the id of the event reporting
+// it. CancelUnpaidOrder cancels the order if it is still pending and no other
+// payment covers it.
+type Store interface {
+	EventSeen(ctx context.Context, eventID string) (bool, error)
+	RecordEvent(ctx context.Context, event Event, paymentID string) error
+	PaymentByTransaction(ctx context.Context, provider, transactionID string) (*pb.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, paymentID, from, to, eventID string) (bool, error)
+	CancelUnpaidOrder(ctx context.Context, orderID, reason string) error
+}
+
+type Handler struct {
+	Secret    []byte
+	Provider  string
+	Store     Store
+	Tolerance time.Duration
+	now       func() time.Time
+}
+
+func NewHandler(secret []byte, provider string, store Store) *Handler {
+	return &Handler{
+		Secret:    secret,
+		Provider:  provider,
+		Store:     store,
+		Tolerance: 5 * time.Minute,
+		now:       time.Now,
+	}
+}
+
+func (h *Handler) Register(r gin.IRouter) {
+	r.POST("/webhooks/payments", h.PaymentNotification)
+}
+
+// PaymentNotification applies a signed processor notification to the payment
+// with the same transaction id. Notifications are applied at most once and
+// only if they move the payment forward, so duplicates and ones that arrive
+// out of order are acknowledged without effect. A failed payment cancels its
+// order while the order is still pending and otherwise unpaid.
+func (h *Handler) PaymentNotification(c *gin.Context) {
+	log, err := l.NewLogger()
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, 1<<20))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unreadable body"})
+		return
+	}
+	if !h.verify(c.GetHeader(SignatureHeader), body) {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid signature"})
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil || event.ID == "" || event.TransactionID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "malformed event"})
+		return
+	}
+	to, ok := eventStatus[event.Type]
+	if !ok {
+		c.JSON(http.StatusOK, gin.H{"status": "ignored"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	seen, err := h.Store.EventSeen(ctx, event.ID)
+	if err != nil {
+		log.Error("error checking payment event", zap.String("event_id", event.ID), zap.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	if seen {
+		c.JSON(http.StatusOK, gin.H{"status": "duplicate"})
+		return
+	}
+
+	record, err := h.Store.PaymentByTransaction(ctx, h.Provider, event.TransactionID)
+	if errors.Is(err, ErrPaymentNotFound) {
+		// The payment may not be committed yet; a non-2xx makes the processor retry.
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown transaction"})
+		return
+	}
+	if err != nil {
+		log.Error("error finding payment", zap.String("transaction_id", event.TransactionID), zap.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	result := "ignored"
+	if payment.Advances(record.Status, to) {
+		updated, err := h.Store.UpdatePaymentStatus(ctx, record.Id, record.Status, to, event.ID)
+		if err != nil {
+			log.Error("error updating payment", zap.String("payment_id", record.Id), zap.Error(err))
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if !updated {
+			// Someone else moved the payment first; let the processor retry
+			// against the new state.
+			c.JSON(http.StatusConflict, gin.H{"error": "payment changed concurrently"})
+			return
+		}
+		result = "applied"
+
+		if to == payment.StatusFailed {
+			if err := h.Store.CancelUnpaidOrder(ctx, record.OrderId, "payment failed"); err != nil {
+				log.Error("error cancelling unpaid order", zap.String("order_id", record.OrderId), zap.Error(err))
+				c.AbortWithStatus(http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	if err := h.Store.RecordEvent(ctx, event, record.Id); err != nil {
+		log.Error("error recording payment event", zap.String("event_id", event.ID), zap.Error(err))
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	log.Info("payment notification", zap.String("event_id", event.ID), zap.String("type", event.Type), zap.String("payment_id", record.Id), zap.String("result", result))
+	c.JSON(http.StatusOK, gin.H{"status": result})
+}
+
+func (h *Handler) verify(header string, body []byte) bool {
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature = value
+		}
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	age := h.now().Sub(time.Unix(unix, 0))
+	if age > h.Tolerance || age < -h.Tolerance {
+		return false
+	}
+
+	want, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(want, mac(h.Secret, timestamp, body))
+}
+
+// Sign returns the SignatureHeader value for body sent at t.
+func Sign(secret []byte, t time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac(secret, timestamp, body))
+}
+
+func mac(secret []byte, timestamp string, body []byte) []byte {
+	m := hmac.New(sha256.New, secret)
+	m.Write([]byte(timestamp))
+	m.Write([]byte("."))
+	m.Write(body)
+	return m.Sum(nil)
+}
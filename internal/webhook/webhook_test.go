@@ -0,0 +1,243 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	pb "Github.com/LocalEats/Order-Service/gen-proto/order"
+	"Github.com/LocalEats/Order-Service/internal/payment"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+)
+
+var secret = []byte("whsec_test")
+
+type memStore struct {
+	mu        sync.Mutex
+	events    map[string]string
+	payments  map[string]*pb.Payment
+	orders    map[string]string
+	updates   int
+	cancelled []string
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		events: map[string]string{},
+		payments: map[string]*pb.Payment{
+			"pay-1": {Id: "pay-1", OrderId: "order-1", Provider: payment.ProviderFake, TransactionId: "tx-1", Status: payment.StatusPending},
+		},
+		orders: map[string]string{"order-1": "pending"},
+	}
+}
+
+func (s *memStore) EventSeen(_ context.Context, eventID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.events[eventID]
+	return ok, nil
+}
+
+func (s *memStore) RecordEvent(_ context.Context, event Event, paymentID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events[event.ID] = paymentID
+	return nil
+}
+
+func (s *memStore) PaymentByTransaction(_ context.Context, provider, transactionID string) (*pb.Payment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.payments {
+		if p.Provider == provider && p.TransactionId == transactionID {
+			return proto.Clone(p).(*pb.Payment), nil
+		}
+	}
+	return nil, ErrPaymentNotFound
+}
+
+func (s *memStore) UpdatePaymentStatus(_ context.Context, paymentID, from, to, _ string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.payments[paymentID]
+	if p.Status != from {
+		return false, nil
+	}
+	p.Status = to
+	s.updates++
+	return true, nil
+}
+
+func (s *memStore) CancelUnpaidOrder(_ context.Context, orderID, reason string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.orders[orderID] == "pending" {
+		s.orders[orderID] = "cancelled"
+		s.cancelled = append(s.cancelled, orderID)
+	}
+	return nil
+}
+
+func (s *memStore) status(paymentID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.payments[paymentID].Status
+}
+
+// fakeProcessor signs and delivers notifications the way a payment processor
+// would.
+type fakeProcessor struct {
+	t      *testing.T
+	url    string
+	secret []byte
+	clock  time.Time
+}
+
+func (p *fakeProcessor) notify(id, eventType, transactionID string) int {
+	body, err := json.Marshal(Event{ID: id, Type: eventType, TransactionID: transactionID, Created: p.clock.Unix()})
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	return p.send(body, Sign(p.secret, p.clock, body))
+}
+
+func (p *fakeProcessor) send(body []byte, signature string) int {
+	req, err := http.NewRequest(http.MethodPost, p.url+"/webhooks/payments", bytes.NewReader(body))
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		p.t.Fatal(err)
+	}
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func setup(t *testing.T) (*memStore, *fakeProcessor) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+
+	gin.SetMode(gin.TestMode)
+	store := newMemStore()
+	now := time.Now()
+	handler := NewHandler(secret, payment.ProviderFake, store)
+	handler.now = func() time.Time { return now }
+
+	router := gin.New()
+	handler.Register(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return store, &fakeProcessor{t: t, url: server.URL, secret: secret, clock: now}
+}
+
+func TestNotificationMovesPaymentForward(t *testing.T) {
+	store, processor := setup(t)
+
+	if code := processor.notify("evt-1", EventAuthorized, "tx-1"); code != http.StatusOK {
+		t.Fatalf("authorized: status %d", code)
+	}
+	if code := processor.notify("evt-2", EventCaptured, "tx-1"); code != http.StatusOK {
+		t.Fatalf("captured: status %d", code)
+	}
+	if got := store.status("pay-1"); got != payment.StatusCaptured {
+		t.Fatalf("payment status = %s, want captured", got)
+	}
+}
+
+func TestDuplicateNotificationsAreAppliedOnce(t *testing.T) {
+	store, processor := setup(t)
+
+	for i := 0; i < 3; i++ {
+		if code := processor.notify("evt-1", EventCaptured, "tx-1"); code != http.StatusOK {
+			t.Fatalf("delivery %d: status %d", i, code)
+		}
+	}
+	if store.updates != 1 {
+		t.Fatalf("payment updated %d times, want 1", store.updates)
+	}
+}
+
+func TestOutOfOrderNotificationsDoNotMoveBackwards(t *testing.T) {
+	store, processor := setup(t)
+
+	processor.notify("evt-2", EventCaptured, "tx-1")
+	if code := processor.notify("evt-1", EventAuthorized, "tx-1"); code != http.StatusOK {
+		t.Fatalf("late authorized: status %d", code)
+	}
+	if got := store.status("pay-1"); got != payment.StatusCaptured {
+		t.Fatalf("payment status = %s, want captured", got)
+	}
+
+	processor.notify("evt-3", EventFailed, "tx-1")
+	if got := store.status("pay-1"); got != payment.StatusCaptured {
+		t.Fatalf("failure after capture moved payment to %s", got)
+	}
+	if len(store.cancelled) != 0 {
+		t.Fatalf("order cancelled for a captured payment")
+	}
+}
+
+func TestFailedPaymentCancelsPendingOrder(t *testing.T) {
+	store, processor := setup(t)
+
+	processor.notify("evt-1", EventFailed, "tx-1")
+	if got := store.status("pay-1"); got != payment.StatusFailed {
+		t.Fatalf("payment status = %s, want failed", got)
+	}
+	if store.orders["order-1"] != "cancelled" {
+		t.Fatalf("order status = %s, want cancelled", store.orders["order-1"])
+	}
+}
+
+func TestRejectsBadSignatures(t *testing.T) {
+	store, processor := setup(t)
+	body := []byte(`{"id":"evt-1","type":"payment.captured","transaction_id":"tx-1"}`)
+
+	cases := map[string]string{
+		"missing":   "",
+		"wrong key": Sign([]byte("other"), processor.clock, body),
+		"stale":     Sign(secret, processor.clock.Add(-10*time.Minute), body),
+		"tampered":  Sign(secret, processor.clock, []byte(`{"id":"evt-1"}`)),
+		"garbage":   fmt.Sprintf("t=%d,v1=zz", processor.clock.Unix()),
+	}
+	for name, signature := range cases {
+		if code := processor.send(body, signature); code != http.StatusUnauthorized {
+			t.Errorf("%s: status %d, want 401", name, code)
+		}
+	}
+	if store.updates != 0 {
+		t.Fatalf("unsigned notification updated the payment")
+	}
+}
+
+func TestUnknownTransactionIsRetried(t *testing.T) {
+	store, processor := setup(t)
+
+	if code := processor.notify("evt-1", EventCaptured, "tx-unknown"); code != http.StatusNotFound {
+		t.Fatalf("status %d, want 404", code)
+	}
+	if seen, _ := store.EventSeen(context.Background(), "evt-1"); seen {
+		t.Fatalf("unmatched event recorded, retries would be dropped as duplicates")
+	}
+}
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS reviews;
+DROP TABLE IF EXISTS order_items;
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS dishes;
+DROP TABLE IF EXISTS kitchens;
+DROP TABLE IF EXISTS revoked_tokens;
+DROP TABLE IF EXISTS password_resets;
+DROP TABLE IF EXISTS users;
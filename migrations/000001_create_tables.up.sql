@@ -0,0 +1,127 @@
+CREATE TABLE IF NOT EXISTS users (
+    id                  UUID PRIMARY KEY,
+    username            VARCHAR(50) NOT NULL,
+    email               VARCHAR(255) NOT NULL,
+    password_hash       TEXT NOT NULL,
+    full_name           VARCHAR(100) NOT NULL DEFAULT '',
+    user_type           VARCHAR(20) NOT NULL DEFAULT 'customer' CHECK (user_type IN ('customer', 'chef', 'admin')),
+    address             TEXT,
+    phone_number        VARCHAR(20),
+    bio                 TEXT,
+    specialties         TEXT[] NOT NULL DEFAULT '{}',
+    years_of_experience INTEGER NOT NULL DEFAULT 0,
+    is_verified         BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at          TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_username_key ON users (LOWER(username)) WHERE deleted_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_key ON users (LOWER(email)) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS password_resets (
+    id         UUID PRIMARY KEY,
+    user_id    UUID NOT NULL REFERENCES users (id),
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    id         TEXT PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS kitchens (
+    id            UUID PRIMARY KEY,
+    owner_id      UUID NOT NULL REFERENCES users (id),
+    name          VARCHAR(100) NOT NULL,
+    description   TEXT,
+    cuisine_type  VARCHAR(50),
+    address       TEXT,
+    phone_number  VARCHAR(20),
+    rating        NUMERIC(3, 2) NOT NULL DEFAULT 0,
+    total_orders  INTEGER NOT NULL DEFAULT 0,
+    working_hours JSONB NOT NULL DEFAULT '[]',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at    TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS kitchens_owner_id_idx ON kitchens (owner_id);
+
+CREATE TABLE IF NOT EXISTS dishes (
+    id             UUID PRIMARY KEY,
+    kitchen_id     UUID NOT NULL REFERENCES kitchens (id),
+    name           VARCHAR(100) NOT NULL,
+    description    TEXT,
+    price          NUMERIC(10, 2) NOT NULL CHECK (price >= 0),
+    category       VARCHAR(50),
+    ingredients    TEXT[] NOT NULL DEFAULT '{}',
+    allergens      TEXT[] NOT NULL DEFAULT '{}',
+    nutrition_info TEXT,
+    calories       INTEGER,
+    protein        INTEGER,
+    carbohydrates  INTEGER,
+    fat            INTEGER,
+    dietary_info   TEXT[] NOT NULL DEFAULT '{}',
+    available      BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS dishes_kitchen_id_idx ON dishes (kitchen_id) WHERE deleted_at IS NULL;
+
+CREATE TABLE IF NOT EXISTS orders (
+    id               UUID PRIMARY KEY,
+    user_id          UUID NOT NULL REFERENCES users (id),
+    kitchen_id       UUID NOT NULL REFERENCES kitchens (id),
+    total_amount     NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    status           VARCHAR(20) NOT NULL DEFAULT 'pending',
+    delivery_address TEXT,
+    delivery_time    TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at       TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS orders_user_id_idx ON orders (user_id);
+CREATE INDEX IF NOT EXISTS orders_kitchen_id_idx ON orders (kitchen_id, created_at);
+
+CREATE TABLE IF NOT EXISTS order_items (
+    id       UUID PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    dish_id  UUID NOT NULL REFERENCES dishes (id),
+    name     VARCHAR(100) NOT NULL,
+    price    NUMERIC(10, 2) NOT NULL CHECK (price >= 0),
+    quantity INTEGER NOT NULL CHECK (quantity > 0)
+);
+
+CREATE INDEX IF NOT EXISTS order_items_order_id_idx ON order_items (order_id);
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id         UUID PRIMARY KEY,
+    order_id   UUID NOT NULL UNIQUE REFERENCES orders (id),
+    user_id    UUID NOT NULL REFERENCES users (id),
+    kitchen_id UUID NOT NULL REFERENCES kitchens (id),
+    rating     NUMERIC(2, 1) NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment    TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS reviews_kitchen_id_idx ON reviews (kitchen_id, created_at);
+
+CREATE TABLE IF NOT EXISTS payments (
+    id             UUID PRIMARY KEY,
+    order_id       UUID NOT NULL REFERENCES orders (id),
+    amount         NUMERIC(10, 2) NOT NULL CHECK (amount >= 0),
+    status         VARCHAR(20) NOT NULL,
+    payment_method VARCHAR(20) NOT NULL,
+    transaction_id VARCHAR(100),
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payments_order_id_idx ON payments (order_id);
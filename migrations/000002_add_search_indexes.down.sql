@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS kitchens_cuisine_type_idx;
+DROP INDEX IF EXISTS kitchens_search_idx;
@@ -0,0 +1,8 @@
+-- Matches kitchenDocument in internal/repository/Kitchen.go.
+CREATE INDEX IF NOT EXISTS kitchens_search_idx ON kitchens USING GIN ((
+    setweight(to_tsvector('simple', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(cuisine_type, '')), 'B') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'C')
+)) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS kitchens_cuisine_type_idx ON kitchens (LOWER(cuisine_type)) WHERE deleted_at IS NULL;
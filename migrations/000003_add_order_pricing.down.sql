@@ -0,0 +1,12 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS discount_code,
+    DROP COLUMN IF EXISTS discount_amount,
+    DROP COLUMN IF EXISTS tax_amount,
+    DROP COLUMN IF EXISTS delivery_fee,
+    DROP COLUMN IF EXISTS subtotal;
+
+DROP TABLE IF EXISTS discounts;
+
+ALTER TABLE kitchens
+    DROP COLUMN IF EXISTS tax_rate,
+    DROP COLUMN IF EXISTS delivery_fee;
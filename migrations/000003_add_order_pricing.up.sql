@@ -0,0 +1,22 @@
+-- NULL means the kitchen uses the service-wide default from the config.
+ALTER TABLE kitchens
+    ADD COLUMN IF NOT EXISTS delivery_fee NUMERIC(10, 2) CHECK (delivery_fee >= 0),
+    ADD COLUMN IF NOT EXISTS tax_rate     NUMERIC(6, 4) CHECK (tax_rate >= 0 AND tax_rate < 1);
+
+CREATE TABLE IF NOT EXISTS discounts (
+    code         VARCHAR(50) PRIMARY KEY,
+    kitchen_id   UUID REFERENCES kitchens (id),
+    percent_off  NUMERIC(5, 2) NOT NULL DEFAULT 0 CHECK (percent_off >= 0 AND percent_off <= 100),
+    amount_off   NUMERIC(10, 2) NOT NULL DEFAULT 0 CHECK (amount_off >= 0),
+    min_subtotal NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    active       BOOLEAN NOT NULL DEFAULT TRUE,
+    expires_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS subtotal        NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS delivery_fee    NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS tax_amount      NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS discount_amount NUMERIC(10, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS discount_code   VARCHAR(50) REFERENCES discounts (code);
@@ -0,0 +1,14 @@
+ALTER TABLE orders
+    ADD CONSTRAINT orders_status_check CHECK (status IN ('pending', 'accepted', 'preparing', 'ready', 'out_for_delivery', 'delivered', 'cancelled', 'rejected'));
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id          UUID PRIMARY KEY,
+    order_id    UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status   VARCHAR(20) NOT NULL,
+    actor_id    UUID NOT NULL REFERENCES users (id),
+    reason      TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_idx ON order_status_history (order_id, created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS refunded_at,
+    DROP COLUMN IF EXISTS refund_transaction_id,
+    DROP COLUMN IF EXISTS refunded_amount;
@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS refunded_amount       NUMERIC(10, 2) NOT NULL DEFAULT 0 CHECK (refunded_amount >= 0),
+    ADD COLUMN IF NOT EXISTS refund_transaction_id VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS refunded_at           TIMESTAMPTZ;
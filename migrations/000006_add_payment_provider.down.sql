@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS payments_transaction_id_idx;
+
+ALTER TABLE payments DROP COLUMN IF EXISTS provider;
@@ -0,0 +1,6 @@
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS provider VARCHAR(20) NOT NULL DEFAULT 'fake';
+
+ALTER TABLE payments ALTER COLUMN provider DROP DEFAULT;
+
+CREATE INDEX IF NOT EXISTS payments_transaction_id_idx ON payments (provider, transaction_id);
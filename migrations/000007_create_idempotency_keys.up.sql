@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    user_id     UUID NOT NULL REFERENCES users (id),
+    method      VARCHAR(100) NOT NULL,
+    key         VARCHAR(255) NOT NULL,
+    fingerprint CHAR(64) NOT NULL,
+    response    BYTEA,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at  TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, method, key)
+);
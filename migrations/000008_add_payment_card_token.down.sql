@@ -0,0 +1,4 @@
+ALTER TABLE payments
+    DROP COLUMN IF EXISTS card_brand,
+    DROP COLUMN IF EXISTS card_last4,
+    DROP COLUMN IF EXISTS card_token;
@@ -0,0 +1,5 @@
+-- Only the provider token and display details are stored; card numbers never are.
+ALTER TABLE payments
+    ADD COLUMN IF NOT EXISTS card_token VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS card_last4 CHAR(4),
+    ADD COLUMN IF NOT EXISTS card_brand VARCHAR(20);
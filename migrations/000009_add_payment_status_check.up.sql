@@ -0,0 +1,4 @@
+UPDATE payments SET status = 'captured' WHERE status = 'success';
+
+ALTER TABLE payments
+    ADD CONSTRAINT payments_status_check CHECK (status IN ('pending', 'authorized', 'captured', 'failed', 'refunded', 'partially_refunded', 'voided'));
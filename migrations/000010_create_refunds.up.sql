@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS refunds (
+    id                 UUID PRIMARY KEY,
+    payment_id         UUID NOT NULL REFERENCES payments (id),
+    order_id           UUID NOT NULL REFERENCES orders (id),
+    amount             NUMERIC(10, 2) NOT NULL CHECK (amount > 0),
+    dish_id            UUID REFERENCES dishes (id),
+    quantity           INTEGER CHECK (quantity > 0),
+    reason             TEXT,
+    provider_refund_id VARCHAR(100),
+    actor_id           UUID NOT NULL REFERENCES users (id),
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS refunds_payment_id_idx ON refunds (payment_id, created_at);
+CREATE INDEX IF NOT EXISTS refunds_order_id_idx ON refunds (order_id);
@@ -0,0 +1,13 @@
+-- Status changes made by the service itself have no actor. They are part of
+-- the order's audit history, so they are never dropped: give them an actor
+-- before rolling back.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM order_status_history WHERE actor_id IS NULL) THEN
+        RAISE EXCEPTION 'status history without an actor exists; set its actor_id before rolling back';
+    END IF;
+END $$;
+
+ALTER TABLE order_status_history ALTER COLUMN actor_id SET NOT NULL;
+
+DROP TABLE IF EXISTS payment_events;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS payment_events (
+    id          VARCHAR(100) PRIMARY KEY,
+    payment_id  UUID NOT NULL REFERENCES payments (id),
+    type        VARCHAR(50) NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payment_events_payment_id_idx ON payment_events (payment_id);
+
+-- Status changes made by the service itself, such as cancelling an order whose
+-- payment failed, have no actor.
+ALTER TABLE order_status_history ALTER COLUMN actor_id DROP NOT NULL;
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS reviews_kitchen_id_created_at_idx;
+CREATE INDEX IF NOT EXISTS reviews_kitchen_id_idx ON reviews (kitchen_id, created_at);
+
+DROP INDEX IF EXISTS orders_user_id_created_at_idx;
+DROP INDEX IF EXISTS orders_kitchen_id_created_at_idx;
+CREATE INDEX IF NOT EXISTS orders_kitchen_id_idx ON orders (kitchen_id, created_at);
+
+DROP INDEX IF EXISTS dishes_kitchen_id_created_at_idx;
@@ -0,0 +1,9 @@
+-- Listings page by (created_at, id) keysets; see internal/pagetoken.
+CREATE INDEX IF NOT EXISTS dishes_kitchen_id_created_at_idx ON dishes (kitchen_id, created_at, id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS orders_kitchen_id_idx;
+CREATE INDEX IF NOT EXISTS orders_kitchen_id_created_at_idx ON orders (kitchen_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS orders_user_id_created_at_idx ON orders (user_id, created_at, id) WHERE deleted_at IS NULL;
+
+DROP INDEX IF EXISTS reviews_kitchen_id_idx;
+CREATE INDEX IF NOT EXISTS reviews_kitchen_id_created_at_idx ON reviews (kitchen_id, created_at, id);
@@ -0,0 +1,11 @@
+-- Refunds reported by the payment provider have no actor. They are part of the
+-- refund ledger that payments.refunded_amount adds up, so they are never
+-- dropped: give them an actor before rolling back.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM refunds WHERE actor_id IS NULL) THEN
+        RAISE EXCEPTION 'refunds without an actor exist; set their actor_id before rolling back';
+    END IF;
+END $$;
+
+ALTER TABLE refunds ALTER COLUMN actor_id SET NOT NULL;
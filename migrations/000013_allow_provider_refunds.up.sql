@@ -0,0 +1,2 @@
+-- Refunds reported by the payment provider's webhook have no acting user.
+ALTER TABLE refunds ALTER COLUMN actor_id DROP NOT NULL;
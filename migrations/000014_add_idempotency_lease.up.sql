@@ -0,0 +1,3 @@
+-- A request holds its in-progress key until locked_until; after that another
+-- request with the same payload may take the key over.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS locked_until TIMESTAMPTZ NOT NULL DEFAULT NOW();
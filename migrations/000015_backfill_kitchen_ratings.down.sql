@@ -0,0 +1 @@
+-- The ratings are derived from reviews, so they are kept.
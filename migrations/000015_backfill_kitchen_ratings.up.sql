@@ -0,0 +1,6 @@
+-- kitchens.rating is the average of the kitchen's reviews, kept up to date by
+-- CreateReview from now on.
+UPDATE kitchens k
+SET rating = r.rating
+FROM (SELECT kitchen_id, ROUND(AVG(rating), 2) AS rating FROM reviews GROUP BY kitchen_id) r
+WHERE r.kitchen_id = k.id;
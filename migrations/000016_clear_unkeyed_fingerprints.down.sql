@@ -0,0 +1 @@
+-- The old fingerprints cannot be restored.
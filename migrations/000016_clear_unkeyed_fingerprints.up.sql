@@ -0,0 +1,5 @@
+-- Fingerprints used to be plain SHA-256 hashes of the request, card number
+-- included, and are now keyed. Blanking the old ones leaves nothing to guess
+-- card numbers from; a replay of such a key fails with AlreadyExists until the
+-- key expires.
+UPDATE idempotency_keys SET fingerprint = '';
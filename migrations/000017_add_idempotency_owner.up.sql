@@ -0,0 +1,3 @@
+-- The request holding a key; only it may complete or release the key. Keys
+-- reserved before this column existed have no owner and simply expire.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS owner UUID;
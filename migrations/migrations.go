@@ -0,0 +1,9 @@
+// Package migrations embeds the versioned SQL schema migrations. The files use
+// the golang-migrate naming scheme, so the Makefile's migrate targets and the
+// service's own `migrate` subcommand can be used interchangeably.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
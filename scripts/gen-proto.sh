@@ -1,7 +1,7 @@
 #!/bin/bash
 echo "Running script with argument: $1"
 CURRENT_DIR=$1
-rm -rf "${CURRENT_DIR}/genproto"
+rm -rf "${CURRENT_DIR}/gen-proto"
 find "${CURRENT_DIR}/protos" -type f -name "*.proto" -print0 | while IFS= read -r -d '' file; do
   protoc -I=${CURRENT_DIR}/protos -I=/usr/local/go --go_out=${CURRENT_DIR} --go-grpc_out=${CURRENT_DIR} "${file}"
 done
\ No newline at end of file